@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+				Description:   "Exact name of the images",
+			},
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsValidRegExp,
+				ConflictsWith: []string{"name"},
+				Description:   "Regular expression the image name must match",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Location of the images (e.g., nl-1)",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching available images, sorted from newest to oldest",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the image",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the image",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the image",
+						},
+						"os": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operating system of the image",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location the image is stored in",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current status of the image",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the image in MB",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	images, err := fetchAllImages(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	matches := filterImages(images, d.Get("name").(string), nameRegex, d.Get("location").(string))
+
+	ids := make([]string, 0, len(matches))
+	result := make([]interface{}, 0, len(matches))
+	for _, image := range matches {
+		attrs := flattenImage(image)
+		id := idToString(image["id"])
+		attrs["id"] = id
+		ids = append(ids, id)
+		result = append(result, attrs)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(ids, ","))))
+	d.Set("images", result)
+
+	return nil
+}
+
+// Helper to fetch every image in the account, following pagination
+func fetchAllImages(token string) ([]map[string]interface{}, error) {
+	const limit = 100
+	var images []map[string]interface{}
+
+	for offset := 0; ; offset += limit {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/images?limit=%d&offset=%d", limit, offset), token, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, err
+		}
+
+		page, _ := resp["images"].([]interface{})
+		for _, i := range page {
+			if image, ok := i.(map[string]interface{}); ok {
+				images = append(images, image)
+			}
+		}
+
+		if len(page) < limit {
+			break
+		}
+	}
+
+	return images, nil
+}
+
+// Helper to filter images that are ready to use and sort the matches from newest to oldest.
+// Empty filter values match any image.
+func filterImages(images []map[string]interface{}, name string, nameRegex *regexp.Regexp, location string) []map[string]interface{} {
+	matches := make([]map[string]interface{}, 0, len(images))
+	for _, image := range images {
+		attrs := flattenImage(image)
+		if !isImageAvailable(attrs["status"].(string)) {
+			continue
+		}
+		if name != "" && attrs["name"] != name {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(attrs["name"].(string)) {
+			continue
+		}
+		if location != "" && attrs["location"] != location {
+			continue
+		}
+		matches = append(matches, image)
+	}
+
+	// RFC 3339 timestamps sort chronologically as strings
+	sort.SliceStable(matches, func(i, j int) bool {
+		return flattenImage(matches[i])["created_at"].(string) > flattenImage(matches[j])["created_at"].(string)
+	})
+
+	return matches
+}
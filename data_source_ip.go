@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Computed attributes shared by the hostman_ip and hostman_ips data sources.
+// They mirror the attributes of the hostman_ip resource.
+func dataSourceIPAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The floating IP address",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Comment of the floating IP",
+		},
+		"is_ddos_guard": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether DDoS protection is enabled",
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Availability zone of the floating IP",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the resource the IP is bound to, if any",
+		},
+		"resource_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the resource the IP is bound to, if any",
+		},
+	}
+}
+
+func dataSourceIP() *schema.Resource {
+	s := dataSourceIPAttributes()
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "ip", "comment"},
+		Description:  "ID of the floating IP to look up",
+	}
+	s["ip"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "ip", "comment"},
+		Description:  "Address of the floating IP to look up",
+	}
+	s["comment"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "ip", "comment"},
+		Description:  "Comment of the floating IP to look up",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIPRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Availability zone of the floating IPs (e.g., ams-1)",
+			},
+			"is_bound": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return floating IPs that are bound (true) or unbound (false) to a resource",
+			},
+			"is_ddos_guard": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return floating IPs with (true) or without (false) DDoS protection",
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching floating IPs",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						s := dataSourceIPAttributes()
+						s["id"] = &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the floating IP",
+						}
+						return s
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	var ip map[string]interface{}
+	if id := d.Get("id").(string); id != "" {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/floating-ips/%s", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		ip = resp["ip"].(map[string]interface{})
+	} else {
+		ips, err := fetchFloatingIPs(token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		field, value := "ip", d.Get("ip").(string)
+		if value == "" {
+			field, value = "comment", d.Get("comment").(string)
+		}
+
+		var matches []map[string]interface{}
+		for _, candidate := range ips {
+			if candidate[field] == value {
+				matches = append(matches, candidate)
+			}
+		}
+		if len(matches) == 0 {
+			return diag.Errorf("no floating IP found with %s %q", field, value)
+		}
+		if len(matches) > 1 {
+			return diag.Errorf("%d floating IPs found with %s %q, look the IP up by id instead", len(matches), field, value)
+		}
+		ip = matches[0]
+	}
+
+	d.SetId(idToString(ip["id"]))
+	for key, value := range flattenFloatingIP(ip) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func dataSourceIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	ips, err := fetchFloatingIPs(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	availabilityZone := d.Get("availability_zone").(string)
+	// Booleans are only used as filters when set explicitly, so that false is not confused with unset
+	isBound, filterBound := getOptionalBool(d, "is_bound")
+	isDDoSGuard, filterDDoSGuard := getOptionalBool(d, "is_ddos_guard")
+
+	ids := make([]string, 0, len(ips))
+	result := make([]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		attrs := flattenFloatingIP(ip)
+		if availabilityZone != "" && attrs["availability_zone"] != availabilityZone {
+			continue
+		}
+		if filterBound && (attrs["resource_id"] != "") != isBound {
+			continue
+		}
+		if filterDDoSGuard && attrs["is_ddos_guard"] != isDDoSGuard {
+			continue
+		}
+
+		id := idToString(ip["id"])
+		attrs["id"] = id
+		ids = append(ids, id)
+		result = append(result, attrs)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(ids, ","))))
+	d.Set("ips", result)
+
+	return nil
+}
+
+// Helper to fetch every floating IP in the account
+func fetchFloatingIPs(token string) ([]map[string]interface{}, error) {
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/floating-ips", token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	var ips []map[string]interface{}
+	list, _ := resp["ips"].([]interface{})
+	for _, i := range list {
+		if ip, ok := i.(map[string]interface{}); ok {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips, nil
+}
+
+// Helper to convert a floating IP API object into data source attributes
+func flattenFloatingIP(ip map[string]interface{}) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"ip":                "",
+		"comment":           "",
+		"is_ddos_guard":     false,
+		"availability_zone": "",
+		"resource_type":     "",
+		"resource_id":       idToString(ip["resource_id"]),
+	}
+
+	if address, ok := ip["ip"].(string); ok {
+		attrs["ip"] = address
+	}
+	if comment, ok := ip["comment"].(string); ok {
+		attrs["comment"] = comment
+	}
+	if isDDoSGuard, ok := ip["is_ddos_guard"].(bool); ok {
+		attrs["is_ddos_guard"] = isDDoSGuard
+	}
+	if availabilityZone, ok := ip["availability_zone"].(string); ok {
+		attrs["availability_zone"] = availabilityZone
+	}
+	if resourceType, ok := ip["resource_type"].(string); ok {
+		attrs["resource_type"] = resourceType
+	}
+
+	return attrs
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// k8sPreset is a master or worker node tariff as returned by /presets/k8s
+type k8sPreset struct {
+	ID               int     `json:"id"`
+	Type             string  `json:"type"`
+	Price            float64 `json:"price"`
+	CPU              int     `json:"cpu"`
+	RAM              int     `json:"ram"`
+	Disk             int     `json:"disk"`
+	Network          int     `json:"network"`
+	Description      string  `json:"description"`
+	DescriptionShort string  `json:"description_short"`
+}
+
+func dataSourceK8sVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceK8sVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"version_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return versions starting with this prefix (e.g., v1.28)",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Available Kubernetes versions, sorted from newest to oldest",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The newest available Kubernetes version",
+			},
+		},
+	}
+}
+
+func dataSourceK8sNetworkDrivers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceK8sNetworkDriversRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_drivers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Supported network drivers (e.g., kuberouter, calico, flannel, cilium)",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceK8sPresets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceK8sPresetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"master", "worker"}, false),
+				Description:  "Node type the presets are for: master or worker",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of CPU cores",
+			},
+			"ram": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "RAM size in MB",
+			},
+			"disk": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Disk size in GB",
+			},
+			"max_price": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum monthly price of the preset",
+			},
+			"presets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching presets, sorted by price from cheapest to most expensive",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Preset ID",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node type: master or worker",
+						},
+						"price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Monthly price of the preset",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of CPU cores",
+						},
+						"ram": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "RAM size in MB",
+						},
+						"disk": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Disk size in GB",
+						},
+						"network": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth in Mbit/s",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the preset",
+						},
+						"description_short": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Short description of the preset",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceK8sVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/k8s/k8s_versions", token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp struct {
+		K8sVersions []string `json:"k8s_versions"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions := filterK8sVersions(resp.K8sVersions, d.Get("version_prefix").(string))
+	if len(versions) == 0 {
+		return diag.Errorf("no Kubernetes versions available matching the given filters")
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(versions, ","))))
+	d.Set("versions", versions)
+	d.Set("latest", versions[0])
+
+	return nil
+}
+
+func dataSourceK8sNetworkDriversRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/k8s/network_drivers", token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp struct {
+		NetworkDrivers []string `json:"network_drivers"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(resp.NetworkDrivers, ","))))
+	d.Set("network_drivers", resp.NetworkDrivers)
+
+	return nil
+}
+
+func dataSourceK8sPresetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/presets/k8s", token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp struct {
+		K8sPresets []k8sPreset `json:"k8s_presets"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := filterK8sPresets(resp.K8sPresets,
+		d.Get("type").(string),
+		d.Get("cpu").(int),
+		d.Get("ram").(int),
+		d.Get("disk").(int),
+		d.Get("max_price").(float64),
+	)
+
+	ids := make([]string, 0, len(matches))
+	result := make([]interface{}, 0, len(matches))
+	for _, preset := range matches {
+		ids = append(ids, strconv.Itoa(preset.ID))
+		result = append(result, map[string]interface{}{
+			"id":                preset.ID,
+			"type":              preset.Type,
+			"price":             preset.Price,
+			"cpu":               preset.CPU,
+			"ram":               preset.RAM,
+			"disk":              preset.Disk,
+			"network":           preset.Network,
+			"description":       preset.Description,
+			"description_short": preset.DescriptionShort,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("presets", result)
+
+	return nil
+}
+
+// Helper to filter Kubernetes versions by prefix and sort them from newest to oldest
+func filterK8sVersions(versions []string, prefix string) []string {
+	result := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if prefix == "" || strings.HasPrefix(v, prefix) {
+			result = append(result, v)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return compareVersions(result[i], result[j]) > 0
+	})
+
+	return result
+}
+
+// Helper to filter Kubernetes presets and sort the matches from cheapest to most expensive.
+// Zero values match any preset.
+func filterK8sPresets(presets []k8sPreset, presetType string, cpu, ram, disk int, maxPrice float64) []k8sPreset {
+	matches := make([]k8sPreset, 0, len(presets))
+	for _, preset := range presets {
+		if presetType != "" && preset.Type != presetType {
+			continue
+		}
+		if cpu != 0 && preset.CPU != cpu {
+			continue
+		}
+		if ram != 0 && preset.RAM != ram {
+			continue
+		}
+		if disk != 0 && preset.Disk != disk {
+			continue
+		}
+		if maxPrice != 0 && preset.Price > maxPrice {
+			continue
+		}
+		matches = append(matches, preset)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Price != matches[j].Price {
+			return matches[i].Price < matches[j].Price
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKubernetes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the cluster to look up",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Name of the cluster to look up",
+			},
+			"k8s_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubernetes version",
+			},
+			"network_driver": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network driver of the cluster",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the Kubernetes cluster",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Availability zone of the cluster",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the cluster",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cluster API endpoint",
+			},
+			"worker_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Worker groups in the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the worker group",
+						},
+						"preset_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Worker node tariff ID",
+						},
+						"configuration": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Worker node configuration parameters",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"configurator_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Configurator ID",
+									},
+									"disk": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Disk size in GB",
+									},
+									"cpu": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of CPU cores",
+									},
+									"ram": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "RAM size in MB",
+									},
+								},
+							},
+						},
+						"node_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of nodes in the group",
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Labels for the node group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Label key",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Label value",
+									},
+								},
+							},
+						},
+						"is_autoscaling": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether autoscaling is enabled for the group",
+						},
+						"min_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of nodes when autoscaling",
+						},
+						"max_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of nodes when autoscaling",
+						},
+					},
+				},
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubeconfig for accessing the cluster",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Kubernetes API server address from the kubeconfig",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM-encoded root certificate of the cluster",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM-encoded client certificate for TLS authentication",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client key for TLS authentication",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for authenticating to the cluster, if the kubeconfig uses one",
+			},
+			"client_certificate_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the client certificate in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	var cluster map[string]interface{}
+	if id := d.Get("id").(string); id != "" {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		cluster = resp["cluster"].(map[string]interface{})
+	} else {
+		name := d.Get("name").(string)
+		body, err := makeRequest("GET", "https://hostman.com/api/v1/k8s/clusters", token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []map[string]interface{}
+		clusters, _ := resp["clusters"].([]interface{})
+		for _, c := range clusters {
+			if candidate, ok := c.(map[string]interface{}); ok && candidate["name"] == name {
+				matches = append(matches, candidate)
+			}
+		}
+		if len(matches) == 0 {
+			return diag.Errorf("no Kubernetes cluster found with name %q", name)
+		}
+		if len(matches) > 1 {
+			return diag.Errorf("%d Kubernetes clusters found with name %q, look the cluster up by id instead", len(matches), name)
+		}
+		cluster = matches[0]
+	}
+
+	id := idToString(cluster["id"])
+	d.SetId(id)
+	d.Set("name", cluster["name"])
+	d.Set("status", cluster["status"])
+
+	if k8sVersion, ok := cluster["k8s_version"].(string); ok {
+		d.Set("k8s_version", k8sVersion)
+	}
+
+	if networkDriver, ok := cluster["network_driver"].(string); ok {
+		d.Set("network_driver", networkDriver)
+	}
+
+	if description, ok := cluster["description"].(string); ok {
+		d.Set("description", description)
+	}
+
+	if availabilityZone, ok := cluster["availability_zone"].(string); ok {
+		d.Set("availability_zone", availabilityZone)
+	}
+
+	if endpoint, ok := cluster["endpoint"].(string); ok {
+		d.Set("endpoint", endpoint)
+	}
+
+	if workerGroups, ok := cluster["worker_groups"].([]interface{}); ok {
+		d.Set("worker_groups", flattenK8sWorkerGroups(workerGroups))
+	}
+
+	// Fetch kubeconfig from dedicated endpoint
+	return readKubeconfig(d, token, id)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Services a location can support, as reported by /locations
+var locationServices = []string{"servers", "k8s", "databases", "floating_ips"}
+
+// location is a region as returned by /locations
+type location struct {
+	Location      string   `json:"location"`
+	LocationCode  string   `json:"location_code"`
+	LocationAlias string   `json:"location_alias"`
+	Zones         []string `json:"zones"`
+	Services      []string `json:"services"`
+}
+
+func dataSourceLocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(locationServices, false),
+				Description:  "Only return locations supporting this service: servers, k8s, databases or floating_ips",
+			},
+			"locations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching locations, sorted by location",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location used by presets and servers (e.g., nl-1)",
+						},
+						"location_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Country code of the location (e.g., nl)",
+						},
+						"location_alias": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human readable name of the location",
+						},
+						"zones": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Availability zones in the location (e.g., ams-1)",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"services": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Services supported in the location",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All availability zones of the matching locations",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/locations", token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp struct {
+		Locations []location `json:"locations"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := filterLocations(resp.Locations, d.Get("service").(string))
+
+	ids := make([]string, 0, len(matches))
+	zones := []string{}
+	result := make([]interface{}, 0, len(matches))
+	for _, loc := range matches {
+		ids = append(ids, loc.Location)
+		zones = append(zones, loc.Zones...)
+		result = append(result, map[string]interface{}{
+			"location":       loc.Location,
+			"location_code":  loc.LocationCode,
+			"location_alias": loc.LocationAlias,
+			"zones":          loc.Zones,
+			"services":       loc.Services,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("locations", result)
+	d.Set("zones", zones)
+
+	return nil
+}
+
+// Helper to filter locations by supported service and sort them by location.
+// An empty service matches any location.
+func filterLocations(locations []location, service string) []location {
+	matches := make([]location, 0, len(locations))
+	for _, loc := range locations {
+		if service == "" {
+			matches = append(matches, loc)
+			continue
+		}
+		for _, s := range loc.Services {
+			if s == service {
+				matches = append(matches, loc)
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Location < matches[j].Location
+	})
+
+	return matches
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverOS is an operating system as returned by /os/servers
+type serverOS struct {
+	ID              int    `json:"id"`
+	Family          string `json:"family"`
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+	VersionCodename string `json:"version_codename"`
+	Description     string `json:"description"`
+}
+
+func dataSourceOS() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOSRead,
+
+		Schema: map[string]*schema.Schema{
+			"family": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Operating system family (e.g., linux, windows)",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Operating system name (e.g., ubuntu, debian, centos)",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Operating system version (e.g., 24.04)",
+			},
+			"version_codename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version codename (e.g., noble, bookworm)",
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If more than one operating system matches, use the most recent version",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the operating system",
+			},
+		},
+	}
+}
+
+func dataSourceOSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/os/servers", token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp struct {
+		ServersOS []serverOS `json:"servers_os"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := filterServerOS(resp.ServersOS,
+		d.Get("family").(string),
+		d.Get("name").(string),
+		d.Get("version").(string),
+		d.Get("version_codename").(string),
+	)
+	if len(matches) == 0 {
+		return diag.Errorf("no operating system matches the given filters")
+	}
+	if len(matches) > 1 && !d.Get("most_recent").(bool) {
+		return diag.Errorf("%d operating systems match the given filters, narrow the search or set most_recent = true", len(matches))
+	}
+
+	system := matches[0]
+	d.SetId(strconv.Itoa(system.ID))
+	d.Set("family", system.Family)
+	d.Set("name", system.Name)
+	d.Set("version", system.Version)
+	d.Set("version_codename", system.VersionCodename)
+	d.Set("description", system.Description)
+
+	return nil
+}
+
+// Helper to filter operating systems and sort the matches from most recent to oldest.
+// Empty filter values match any operating system.
+func filterServerOS(list []serverOS, family, name, version, codename string) []serverOS {
+	matches := make([]serverOS, 0, len(list))
+	for _, system := range list {
+		if family != "" && system.Family != family {
+			continue
+		}
+		if name != "" && system.Name != name {
+			continue
+		}
+		if version != "" && system.Version != version {
+			continue
+		}
+		if codename != "" && system.VersionCodename != codename {
+			continue
+		}
+		matches = append(matches, system)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if c := compareVersions(matches[i].Version, matches[j].Version); c != 0 {
+			return c > 0
+		}
+		return matches[i].ID > matches[j].ID
+	})
+
+	return matches
+}
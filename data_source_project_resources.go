@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Resource collections reported by /projects/{id}/resources
+var projectResourceTypes = []string{"servers", "clusters", "floating_ips", "balancers", "databases", "buckets"}
+
+func dataSourceProjectResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the project",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(projectResourceTypes, false),
+				Description:  "Only return resources of this type: servers, clusters, floating_ips, balancers, databases or buckets",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Resources in the project, sorted by type and ID",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the resource (e.g., servers)",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource. Floating IPs report their address",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	projectID := d.Get("project_id").(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/projects/%s/resources", projectID), token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectID)
+	d.Set("resources", flattenProjectResources(resp, d.Get("type").(string)))
+
+	return nil
+}
+
+// Helper to convert the /projects/{id}/resources response into a flat list sorted by type and ID.
+// An empty resourceType matches any resource.
+func flattenProjectResources(resp map[string]interface{}, resourceType string) []interface{} {
+	type projectResource struct {
+		Type, ID, Name string
+	}
+
+	var matches []projectResource
+	for _, t := range projectResourceTypes {
+		if resourceType != "" && t != resourceType {
+			continue
+		}
+		items, _ := resp[t].([]interface{})
+		for _, i := range items {
+			item, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := item["name"].(string)
+			if name == "" {
+				name, _ = item["ip"].(string)
+			}
+			matches = append(matches, projectResource{Type: t, ID: idToString(item["id"]), Name: name})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Type != matches[j].Type {
+			return matches[i].Type < matches[j].Type
+		}
+		return compareVersions(matches[i].ID, matches[j].ID) < 0
+	})
+
+	result := make([]interface{}, 0, len(matches))
+	for _, m := range matches {
+		result = append(result, map[string]interface{}{
+			"type": m.Type,
+			"id":   m.ID,
+			"name": m.Name,
+		})
+	}
+	return result
+}
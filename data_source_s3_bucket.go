@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceS3Bucket() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceS3BucketRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the bucket to look up",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Name of the bucket to look up",
+			},
+			"preset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Storage preset ID",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access type of the bucket: private or public",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location of the bucket",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "S3 endpoint URL of the bucket",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "S3 access key",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "S3 secret key",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the bucket",
+			},
+		},
+	}
+}
+
+func dataSourceS3BucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	var bucket map[string]interface{}
+	if id := d.Get("id").(string); id != "" {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		bucket = resp["bucket"].(map[string]interface{})
+	} else {
+		name := d.Get("name").(string)
+		body, err := makeRequest("GET", "https://hostman.com/api/v1/storages/buckets", token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Bucket names are globally unique, so at most one bucket matches
+		buckets, _ := resp["buckets"].([]interface{})
+		for _, b := range buckets {
+			if candidate, ok := b.(map[string]interface{}); ok && candidate["name"] == name {
+				bucket = candidate
+				break
+			}
+		}
+		if bucket == nil {
+			return diag.Errorf("no S3 bucket found with name %q", name)
+		}
+	}
+
+	d.SetId(idToString(bucket["id"]))
+	for key, value := range flattenS3Bucket(bucket) {
+		d.Set(key, value)
+	}
+
+	accessKey, secretKey, err := fetchS3Credentials(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("access_key", accessKey)
+	d.Set("secret_key", secretKey)
+
+	return nil
+}
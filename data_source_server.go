@@ -0,0 +1,379 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Computed attributes shared by the hostman_server and hostman_servers data sources.
+// They mirror the arguments of the hostman_server resource.
+func dataSourceServerAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the server",
+		},
+		"bandwidth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Bandwidth in Mbit/s",
+		},
+		"preset_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Server preset ID",
+		},
+		"os_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Operating system ID",
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Image ID the server was created from",
+		},
+		"is_ddos_guard": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether DDoS protection is enabled",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Current status of the server",
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Location of the server (e.g., nl-1)",
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Availability zone of the server",
+		},
+		"ipv4": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IPv4 address of the server",
+		},
+		"vpc_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the VPC the server is attached to",
+		},
+		"local_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Private IPv4 address of the server in the VPC",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the project the server belongs to",
+		},
+		"boot_disk_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the system disk of the server",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Tags of the server",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func dataSourceServer() *schema.Resource {
+	s := dataSourceServerAttributes()
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+		Description:  "ID of the server to look up",
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+		Description:  "Name of the server to look up",
+	}
+	s["root_pass"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The root password for the server",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceServerRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the server name must match",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Location of the servers (e.g., nl-1)",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Status of the servers (e.g., on, off)",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tags the servers must have. All listed tags must be present",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching servers",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						s := dataSourceServerAttributes()
+						s["id"] = &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the server",
+						}
+						return s
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	var server map[string]interface{}
+	if id := d.Get("id").(string); id != "" {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/servers/%s", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		server = resp["server"].(map[string]interface{})
+	} else {
+		name := d.Get("name").(string)
+		servers, err := fetchAllServers(token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []map[string]interface{}
+		for _, s := range servers {
+			if s["name"] == name {
+				matches = append(matches, s)
+			}
+		}
+		if len(matches) == 0 {
+			return diag.Errorf("no server found with name %q", name)
+		}
+		if len(matches) > 1 {
+			return diag.Errorf("%d servers found with name %q, look the server up by id instead", len(matches), name)
+		}
+		server = matches[0]
+	}
+
+	d.SetId(idToString(server["id"]))
+	for key, value := range flattenServer(server) {
+		d.Set(key, value)
+	}
+	d.Set("root_pass", server["root_pass"])
+
+	return nil
+}
+
+func dataSourceServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	servers, err := fetchAllServers(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+	location := d.Get("location").(string)
+	status := d.Get("status").(string)
+	var tags []string
+	for _, tag := range d.Get("tags").([]interface{}) {
+		tags = append(tags, tag.(string))
+	}
+
+	ids := make([]string, 0, len(servers))
+	result := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		attrs := flattenServer(server)
+		if nameRegex != nil && !nameRegex.MatchString(attrs["name"].(string)) {
+			continue
+		}
+		if location != "" && attrs["location"] != location {
+			continue
+		}
+		if status != "" && attrs["status"] != status {
+			continue
+		}
+		if !hasAllTags(attrs["tags"].([]string), tags) {
+			continue
+		}
+
+		id := idToString(server["id"])
+		attrs["id"] = id
+		ids = append(ids, id)
+		result = append(result, attrs)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(ids, ","))))
+	d.Set("servers", result)
+
+	return nil
+}
+
+// Helper to fetch every server in the account, following pagination
+func fetchAllServers(token string) ([]map[string]interface{}, error) {
+	const limit = 100
+	var servers []map[string]interface{}
+
+	for offset := 0; ; offset += limit {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/servers?limit=%d&offset=%d", limit, offset), token, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, err
+		}
+
+		page, _ := resp["servers"].([]interface{})
+		for _, s := range page {
+			if server, ok := s.(map[string]interface{}); ok {
+				servers = append(servers, server)
+			}
+		}
+
+		if len(page) < limit {
+			break
+		}
+	}
+
+	return servers, nil
+}
+
+// Helper to convert a server API object into data source attributes
+func flattenServer(server map[string]interface{}) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":              "",
+		"bandwidth":         0,
+		"preset_id":         0,
+		"os_id":             0,
+		"image_id":          "",
+		"is_ddos_guard":     false,
+		"status":            "",
+		"location":          "",
+		"availability_zone": "",
+		"ipv4":              "",
+		"boot_disk_id":      serverBootDiskID(server),
+		"project_id":        idToString(server["project_id"]),
+		"tags":              []string{},
+	}
+	attrs["vpc_id"], attrs["local_ip"] = serverLocalNetwork(server)
+
+	if name, ok := server["name"].(string); ok {
+		attrs["name"] = name
+	}
+	if presetID, ok := server["preset_id"].(float64); ok {
+		attrs["preset_id"] = int(presetID)
+	}
+	if osInfo, ok := server["os"].(map[string]interface{}); ok {
+		if osID, ok := osInfo["id"].(float64); ok {
+			attrs["os_id"] = int(osID)
+		}
+	}
+	if image, ok := server["image"].(map[string]interface{}); ok {
+		attrs["image_id"] = idToString(image["id"])
+	}
+	if isDDoSGuard, ok := server["is_ddos_guard"].(bool); ok {
+		attrs["is_ddos_guard"] = isDDoSGuard
+	}
+	if status, ok := server["status"].(string); ok {
+		attrs["status"] = status
+	}
+	if location, ok := server["location"].(string); ok {
+		attrs["location"] = location
+	}
+	if availabilityZone, ok := server["availability_zone"].(string); ok {
+		attrs["availability_zone"] = availabilityZone
+	}
+
+	// Bandwidth and addresses are reported per network
+	if networks, ok := server["networks"].([]interface{}); ok {
+		for _, n := range networks {
+			network, ok := n.(map[string]interface{})
+			if !ok || network["type"] != "public" {
+				continue
+			}
+			if bandwidth, ok := network["bandwidth"].(float64); ok {
+				attrs["bandwidth"] = int(bandwidth)
+			}
+			ips, _ := network["ips"].([]interface{})
+			for _, i := range ips {
+				ip, ok := i.(map[string]interface{})
+				if ok && ip["type"] == "ipv4" && attrs["ipv4"] == "" {
+					attrs["ipv4"] = fmt.Sprintf("%v", ip["ip"])
+				}
+			}
+		}
+	}
+	if bandwidth, ok := server["bandwidth"].(float64); ok {
+		attrs["bandwidth"] = int(bandwidth)
+	}
+
+	tags := []string{}
+	if list, ok := server["tags"].([]interface{}); ok {
+		for _, t := range list {
+			if tag, ok := t.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	attrs["tags"] = tags
+
+	return attrs
+}
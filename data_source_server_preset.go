@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverPreset is a server tariff as returned by /presets/servers
+type serverPreset struct {
+	ID               int      `json:"id"`
+	Location         string   `json:"location"`
+	Price            float64  `json:"price"`
+	CPU              int      `json:"cpu"`
+	CPUFrequency     float64  `json:"cpu_frequency"`
+	RAM              int      `json:"ram"`
+	Disk             int      `json:"disk"`
+	DiskType         string   `json:"disk_type"`
+	Bandwidth        int      `json:"bandwidth"`
+	Description      string   `json:"description"`
+	DescriptionShort string   `json:"description_short"`
+	Tags             []string `json:"tags"`
+}
+
+// serverPresetFilter holds the optional filter arguments shared by the preset data sources.
+// Zero values mean "any".
+type serverPresetFilter struct {
+	Location  string
+	CPU       int
+	RAM       int
+	Disk      int
+	DiskType  string
+	Bandwidth int
+	MaxPrice  float64
+	Tags      []string
+}
+
+func serverPresetFilterSchema(computed bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"location": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Location of the preset (e.g., nl-1, us-2, us-3)",
+		},
+		"cpu": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Number of CPU cores",
+		},
+		"ram": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    computed,
+			Description: "RAM size in MB",
+		},
+		"disk": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Disk size in MB",
+		},
+		"disk_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Disk type (e.g., nvme, ssd, hdd)",
+		},
+		"bandwidth": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    computed,
+			Description: "Bandwidth in Mbit/s",
+		},
+		"max_price": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum monthly price of the preset",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Tags the preset must have. All listed tags must be present",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func dataSourceServerPreset() *schema.Resource {
+	s := serverPresetFilterSchema(true)
+	s["price"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "Monthly price of the preset",
+	}
+	s["cpu_frequency"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "CPU frequency in GHz",
+	}
+	s["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Description of the preset",
+	}
+	s["description_short"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Short description of the preset",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceServerPresetRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceServerPresets() *schema.Resource {
+	s := serverPresetFilterSchema(false)
+	s["presets"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Matching presets, sorted by price from cheapest to most expensive",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Preset ID",
+				},
+				"location": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Location of the preset",
+				},
+				"price": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "Monthly price of the preset",
+				},
+				"cpu": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of CPU cores",
+				},
+				"cpu_frequency": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "CPU frequency in GHz",
+				},
+				"ram": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "RAM size in MB",
+				},
+				"disk": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Disk size in MB",
+				},
+				"disk_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Disk type",
+				},
+				"bandwidth": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Bandwidth in Mbit/s",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Description of the preset",
+				},
+				"description_short": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Short description of the preset",
+				},
+				"tags": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Tags of the preset",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceServerPresetsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceServerPresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	presets, err := fetchServerPresets(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := filterServerPresets(presets, getServerPresetFilter(d))
+	if len(matches) == 0 {
+		return diag.Errorf("no server preset matches the given filters")
+	}
+
+	preset := matches[0]
+	d.SetId(strconv.Itoa(preset.ID))
+	d.Set("location", preset.Location)
+	d.Set("price", preset.Price)
+	d.Set("cpu", preset.CPU)
+	d.Set("cpu_frequency", preset.CPUFrequency)
+	d.Set("ram", preset.RAM)
+	d.Set("disk", preset.Disk)
+	d.Set("disk_type", preset.DiskType)
+	d.Set("bandwidth", preset.Bandwidth)
+	d.Set("description", preset.Description)
+	d.Set("description_short", preset.DescriptionShort)
+
+	return nil
+}
+
+func dataSourceServerPresetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	presets, err := fetchServerPresets(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := filterServerPresets(presets, getServerPresetFilter(d))
+
+	ids := make([]string, 0, len(matches))
+	result := make([]interface{}, 0, len(matches))
+	for _, preset := range matches {
+		ids = append(ids, strconv.Itoa(preset.ID))
+		result = append(result, map[string]interface{}{
+			"id":                preset.ID,
+			"location":          preset.Location,
+			"price":             preset.Price,
+			"cpu":               preset.CPU,
+			"cpu_frequency":     preset.CPUFrequency,
+			"ram":               preset.RAM,
+			"disk":              preset.Disk,
+			"disk_type":         preset.DiskType,
+			"bandwidth":         preset.Bandwidth,
+			"description":       preset.Description,
+			"description_short": preset.DescriptionShort,
+			"tags":              preset.Tags,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("presets", result)
+
+	return nil
+}
+
+// Helper to fetch all server presets from the API
+func fetchServerPresets(token string) ([]serverPreset, error) {
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/presets/servers", token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		ServerPresets []serverPreset `json:"server_presets"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.ServerPresets, nil
+}
+
+// Helper to read the filter arguments from the data source configuration
+func getServerPresetFilter(d *schema.ResourceData) serverPresetFilter {
+	filter := serverPresetFilter{
+		Location:  d.Get("location").(string),
+		CPU:       d.Get("cpu").(int),
+		RAM:       d.Get("ram").(int),
+		Disk:      d.Get("disk").(int),
+		DiskType:  d.Get("disk_type").(string),
+		Bandwidth: d.Get("bandwidth").(int),
+		MaxPrice:  d.Get("max_price").(float64),
+	}
+	for _, tag := range d.Get("tags").([]interface{}) {
+		filter.Tags = append(filter.Tags, tag.(string))
+	}
+	return filter
+}
+
+// Helper to filter presets and sort the matches from cheapest to most expensive
+func filterServerPresets(presets []serverPreset, filter serverPresetFilter) []serverPreset {
+	matches := make([]serverPreset, 0, len(presets))
+	for _, preset := range presets {
+		if filter.Location != "" && preset.Location != filter.Location {
+			continue
+		}
+		if filter.CPU != 0 && preset.CPU != filter.CPU {
+			continue
+		}
+		if filter.RAM != 0 && preset.RAM != filter.RAM {
+			continue
+		}
+		if filter.Disk != 0 && preset.Disk != filter.Disk {
+			continue
+		}
+		if filter.DiskType != "" && preset.DiskType != filter.DiskType {
+			continue
+		}
+		if filter.Bandwidth != 0 && preset.Bandwidth != filter.Bandwidth {
+			continue
+		}
+		if filter.MaxPrice != 0 && preset.Price > filter.MaxPrice {
+			continue
+		}
+		if !hasAllTags(preset.Tags, filter.Tags) {
+			continue
+		}
+		matches = append(matches, preset)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Price != matches[j].Price {
+			return matches[i].Price < matches[j].Price
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
+
+// Helper to check that every wanted tag is present
+func hasAllTags(tags, wanted []string) bool {
+	for _, w := range wanted {
+		if !containsString(tags, w) {
+			return false
+		}
+	}
+	return true
+}
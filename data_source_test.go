@@ -0,0 +1,521 @@
+package main
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestDataSourcesRegistered(t *testing.T) {
+	provider := Provider()
+
+	expectedDataSources := []string{
+		"hostman_server_preset",
+		"hostman_server_presets",
+		"hostman_os",
+		"hostman_server",
+		"hostman_servers",
+		"hostman_k8s_versions",
+		"hostman_k8s_network_drivers",
+		"hostman_k8s_presets",
+		"hostman_kubernetes",
+		"hostman_ip",
+		"hostman_ips",
+		"hostman_locations",
+		"hostman_images",
+		"hostman_s3_bucket",
+		"hostman_project_resources",
+	}
+
+	for _, name := range expectedDataSources {
+		dataSource, ok := provider.DataSourcesMap[name]
+		if !ok {
+			t.Errorf("%s data source not found", name)
+			continue
+		}
+		if dataSource.ReadContext == nil {
+			t.Errorf("%s: ReadContext is nil", name)
+		}
+	}
+}
+
+func testServerPresets() []serverPreset {
+	return []serverPreset{
+		{ID: 3743, Location: "pl-1", Price: 2, CPU: 1, RAM: 1024, Disk: 25600, DiskType: "nvme", Bandwidth: 100, Tags: []string{"cp", "discount_euro"}},
+		{ID: 3933, Location: "nl-1", Price: 2, CPU: 1, RAM: 1024, Disk: 25600, DiskType: "nvme", Bandwidth: 200, Tags: []string{"cp", "nl_base", "nl_nvme_hm"}},
+		{ID: 5161, Location: "nl-1", Price: 4, CPU: 2, RAM: 2048, Disk: 61440, DiskType: "nvme", Bandwidth: 200, Tags: []string{"cp", "nl_base"}},
+		{ID: 5224, Location: "nl-1", Price: 3, CPU: 1, RAM: 2048, Disk: 40960, DiskType: "nvme", Bandwidth: 200, Tags: []string{"cp", "nl_base"}},
+		{ID: 5511, Location: "us-3", Price: 4, CPU: 2, RAM: 2048, Disk: 61440, DiskType: "nvme", Bandwidth: 200, Tags: []string{"cp", "sjc_nvme_hm"}},
+	}
+}
+
+func TestFilterServerPresets(t *testing.T) {
+	testCases := []struct {
+		name        string
+		filter      serverPresetFilter
+		expectedIDs []int
+	}{
+		{
+			name:        "no filters sorts by price then id",
+			filter:      serverPresetFilter{},
+			expectedIDs: []int{3743, 3933, 5224, 5161, 5511},
+		},
+		{
+			name:        "location",
+			filter:      serverPresetFilter{Location: "nl-1"},
+			expectedIDs: []int{3933, 5224, 5161},
+		},
+		{
+			name:        "cpu and ram",
+			filter:      serverPresetFilter{CPU: 2, RAM: 2048},
+			expectedIDs: []int{5161, 5511},
+		},
+		{
+			name:        "max price",
+			filter:      serverPresetFilter{Location: "nl-1", MaxPrice: 3},
+			expectedIDs: []int{3933, 5224},
+		},
+		{
+			name:        "tags must all match",
+			filter:      serverPresetFilter{Tags: []string{"cp", "nl_nvme_hm"}},
+			expectedIDs: []int{3933},
+		},
+		{
+			name:        "bandwidth and disk type",
+			filter:      serverPresetFilter{Bandwidth: 100, DiskType: "nvme"},
+			expectedIDs: []int{3743},
+		},
+		{
+			name:        "no match",
+			filter:      serverPresetFilter{Location: "pl-1", CPU: 8},
+			expectedIDs: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := filterServerPresets(testServerPresets(), tc.filter)
+			if len(matches) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d matches, got %d", len(tc.expectedIDs), len(matches))
+			}
+			for i, id := range tc.expectedIDs {
+				if matches[i].ID != id {
+					t.Errorf("match %d: expected preset %d, got %d", i, id, matches[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func testServerOS() []serverOS {
+	return []serverOS{
+		{ID: 47, Family: "linux", Name: "ubuntu", Version: "18.04", VersionCodename: "bionic"},
+		{ID: 61, Family: "linux", Name: "ubuntu", Version: "20.04", VersionCodename: "focal"},
+		{ID: 67, Family: "linux", Name: "debian", Version: "11", VersionCodename: "bullseye"},
+		{ID: 79, Family: "linux", Name: "ubuntu", Version: "22.04", VersionCodename: "jammy"},
+		{ID: 95, Family: "linux", Name: "debian", Version: "12", VersionCodename: "bookworm"},
+		{ID: 99, Family: "linux", Name: "ubuntu", Version: "24.04", VersionCodename: "noble"},
+	}
+}
+
+func TestFilterServerOS(t *testing.T) {
+	testCases := []struct {
+		name        string
+		osName      string
+		version     string
+		codename    string
+		expectedIDs []int
+	}{
+		{
+			name:        "name and version",
+			osName:      "ubuntu",
+			version:     "24.04",
+			expectedIDs: []int{99},
+		},
+		{
+			name:        "codename",
+			codename:    "bookworm",
+			expectedIDs: []int{95},
+		},
+		{
+			name:        "name sorted most recent first",
+			osName:      "ubuntu",
+			expectedIDs: []int{99, 79, 61, 47},
+		},
+		{
+			name:        "no match",
+			osName:      "centos",
+			expectedIDs: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := filterServerOS(testServerOS(), "", tc.osName, tc.version, tc.codename)
+			if len(matches) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d matches, got %d", len(tc.expectedIDs), len(matches))
+			}
+			for i, id := range tc.expectedIDs {
+				if matches[i].ID != id {
+					t.Errorf("match %d: expected OS %d, got %d", i, id, matches[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceServerMirrorsResource(t *testing.T) {
+	dataSource := dataSourceServer()
+
+	for field := range resourceServer().Schema {
+		if _, ok := dataSource.Schema[field]; !ok {
+			t.Errorf("resource attribute %q not exposed by the hostman_server data source", field)
+		}
+	}
+
+	if !dataSource.Schema["root_pass"].Sensitive {
+		t.Error("expected root_pass to be sensitive")
+	}
+}
+
+func TestFlattenServer(t *testing.T) {
+	var server map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"id": 1234567,
+		"name": "web-1",
+		"status": "on",
+		"preset_id": 3933,
+		"location": "nl-1",
+		"availability_zone": "ams-1",
+		"is_ddos_guard": true,
+		"os": {"id": 99, "name": "ubuntu", "version": "24.04"},
+		"disks": [{"id": 201, "size": 10240, "is_system": false}, {"id": 200, "size": 51200, "is_system": true}],
+		"networks": [
+			{"type": "public", "bandwidth": 200, "ips": [{"type": "ipv6", "ip": "2a03::1"}, {"type": "ipv4", "ip": "203.0.113.10"}]},
+			{"type": "local", "id": "network-1a2b3c", "ips": [{"type": "ipv4", "ip": "192.168.0.4"}]}
+		],
+		"tags": ["web", "prod"]
+	}`), &server)
+	if err != nil {
+		t.Fatalf("failed to unmarshal test server: %v", err)
+	}
+
+	attrs := flattenServer(server)
+	expected := map[string]interface{}{
+		"name":              "web-1",
+		"status":            "on",
+		"preset_id":         3933,
+		"os_id":             99,
+		"location":          "nl-1",
+		"availability_zone": "ams-1",
+		"is_ddos_guard":     true,
+		"bandwidth":         200,
+		"ipv4":              "203.0.113.10",
+		"image_id":          "",
+		"boot_disk_id":      200,
+		"vpc_id":            "network-1a2b3c",
+		"local_ip":          "192.168.0.4",
+	}
+	for key, value := range expected {
+		if attrs[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, attrs[key])
+		}
+	}
+
+	tags := attrs["tags"].([]string)
+	if len(tags) != 2 || tags[0] != "web" || tags[1] != "prod" {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+}
+
+func TestFilterK8sVersions(t *testing.T) {
+	versions := []string{"v1.27.5+k0s.0", "v1.29.1+k0s.0", "v1.28.4+k0s.0", "v1.28.10+k0s.0"}
+
+	result := filterK8sVersions(versions, "")
+	expected := []string{"v1.29.1+k0s.0", "v1.28.10+k0s.0", "v1.28.4+k0s.0", "v1.27.5+k0s.0"}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d versions, got %d", len(expected), len(result))
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("version %d: expected %q, got %q", i, expected[i], result[i])
+		}
+	}
+
+	result = filterK8sVersions(versions, "v1.28")
+	if len(result) != 2 || result[0] != "v1.28.10+k0s.0" {
+		t.Errorf("expected v1.28 versions with v1.28.10+k0s.0 first, got %v", result)
+	}
+}
+
+func TestFilterK8sPresets(t *testing.T) {
+	presets := []k8sPreset{
+		{ID: 403, Type: "master", Price: 20, CPU: 4, RAM: 8192, Disk: 80},
+		{ID: 405, Type: "master", Price: 10, CPU: 2, RAM: 4096, Disk: 40},
+		{ID: 1745, Type: "worker", Price: 8, CPU: 2, RAM: 4096, Disk: 40},
+		{ID: 1747, Type: "worker", Price: 16, CPU: 4, RAM: 8192, Disk: 80},
+	}
+
+	testCases := []struct {
+		name        string
+		presetType  string
+		cpu         int
+		maxPrice    float64
+		expectedIDs []int
+	}{
+		{
+			name:        "masters sorted by price",
+			presetType:  "master",
+			expectedIDs: []int{405, 403},
+		},
+		{
+			name:        "workers with 4 cpu",
+			presetType:  "worker",
+			cpu:         4,
+			expectedIDs: []int{1747},
+		},
+		{
+			name:        "max price across types",
+			maxPrice:    10,
+			expectedIDs: []int{1745, 405},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := filterK8sPresets(presets, tc.presetType, tc.cpu, 0, 0, tc.maxPrice)
+			if len(matches) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d matches, got %d", len(tc.expectedIDs), len(matches))
+			}
+			for i, id := range tc.expectedIDs {
+				if matches[i].ID != id {
+					t.Errorf("match %d: expected preset %d, got %d", i, id, matches[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceKubernetesSensitiveFields(t *testing.T) {
+	dataSource := dataSourceKubernetes()
+
+	for _, field := range []string{"kubeconfig", "client_key", "token"} {
+		if !dataSource.Schema[field].Sensitive {
+			t.Errorf("expected field %q to be sensitive", field)
+		}
+	}
+}
+
+func TestFlattenK8sWorkerGroups(t *testing.T) {
+	var cluster map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"worker_groups": [
+			{"name": "workers", "preset_id": 1745, "node_count": 3, "labels": [{"key": "tier", "value": "web"}]},
+			{"name": "autoscale", "node_count": 2, "is_autoscaling": true, "min-size": 2, "max-size": 10,
+			 "configuration": {"configurator_id": 57, "disk": 512, "cpu": 4, "ram": 16384}}
+		]
+	}`), &cluster)
+	if err != nil {
+		t.Fatalf("failed to unmarshal test cluster: %v", err)
+	}
+
+	groups := flattenK8sWorkerGroups(cluster["worker_groups"].([]interface{}))
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 worker groups, got %d", len(groups))
+	}
+
+	workers := groups[0].(map[string]interface{})
+	if workers["name"] != "workers" || workers["preset_id"] != 1745 || workers["node_count"] != 3 {
+		t.Errorf("unexpected first worker group: %v", workers)
+	}
+	labels := workers["labels"].([]interface{})
+	if len(labels) != 1 || labels[0].(map[string]interface{})["value"] != "web" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+
+	autoscale := groups[1].(map[string]interface{})
+	if autoscale["is_autoscaling"] != true || autoscale["min_size"] != 2 || autoscale["max_size"] != 10 {
+		t.Errorf("unexpected autoscaling fields: %v", autoscale)
+	}
+	config := autoscale["configuration"].([]interface{})[0].(map[string]interface{})
+	if config["ram"] != 16384 {
+		t.Errorf("expected configuration ram 16384, got %v", config["ram"])
+	}
+}
+
+func TestFlattenFloatingIP(t *testing.T) {
+	var bound, unbound map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "ip-1", "ip": "203.0.113.5", "comment": "ingress", "is_ddos_guard": true, "availability_zone": "ams-1", "resource_type": "server", "resource_id": 1234567}`), &bound); err != nil {
+		t.Fatalf("failed to unmarshal test IP: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"id": "ip-2", "ip": "203.0.113.6", "comment": null, "is_ddos_guard": false, "availability_zone": "ams-1", "resource_type": null, "resource_id": null}`), &unbound); err != nil {
+		t.Fatalf("failed to unmarshal test IP: %v", err)
+	}
+
+	attrs := flattenFloatingIP(bound)
+	if attrs["ip"] != "203.0.113.5" || attrs["comment"] != "ingress" || attrs["is_ddos_guard"] != true {
+		t.Errorf("unexpected attributes for bound IP: %v", attrs)
+	}
+	if attrs["resource_type"] != "server" || attrs["resource_id"] != "1234567" {
+		t.Errorf("unexpected binding for bound IP: %v", attrs)
+	}
+
+	attrs = flattenFloatingIP(unbound)
+	if attrs["comment"] != "" || attrs["resource_type"] != "" || attrs["resource_id"] != "" {
+		t.Errorf("expected empty binding for unbound IP, got %v", attrs)
+	}
+}
+
+func TestFilterLocations(t *testing.T) {
+	locations := []location{
+		{Location: "us-2", Zones: []string{"sfo-1"}, Services: []string{"servers", "floating_ips"}},
+		{Location: "nl-1", Zones: []string{"ams-1"}, Services: []string{"servers", "k8s", "databases", "floating_ips"}},
+		{Location: "us-3", Zones: []string{"nyc-1"}, Services: []string{"servers", "k8s"}},
+	}
+
+	testCases := []struct {
+		name     string
+		service  string
+		expected []string
+	}{
+		{name: "no filter", expected: []string{"nl-1", "us-2", "us-3"}},
+		{name: "k8s", service: "k8s", expected: []string{"nl-1", "us-3"}},
+		{name: "databases", service: "databases", expected: []string{"nl-1"}},
+		{name: "floating IPs", service: "floating_ips", expected: []string{"nl-1", "us-2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := filterLocations(locations, tc.service)
+			if len(matches) != len(tc.expected) {
+				t.Fatalf("expected %d matches, got %d", len(tc.expected), len(matches))
+			}
+			for i, loc := range tc.expected {
+				if matches[i].Location != loc {
+					t.Errorf("match %d: expected location %s, got %s", i, loc, matches[i].Location)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterImages(t *testing.T) {
+	var images []map[string]interface{}
+	err := json.Unmarshal([]byte(`[
+		{"id": "img-1", "name": "golden-2024-01", "status": "created", "location": "nl-1", "created_at": "2024-01-10T10:00:00Z"},
+		{"id": "img-2", "name": "golden-2024-03", "status": "created", "location": "nl-1", "created_at": "2024-03-10T10:00:00Z"},
+		{"id": "img-3", "name": "golden-2024-04", "status": "new", "location": "nl-1", "created_at": "2024-04-10T10:00:00Z"},
+		{"id": "img-4", "name": "golden-2024-02", "status": "created", "location": "us-2", "created_at": "2024-02-10T10:00:00Z"},
+		{"id": "img-5", "name": "scratch", "status": "created", "location": "nl-1", "created_at": "2024-05-10T10:00:00Z"}
+	]`), &images)
+	if err != nil {
+		t.Fatalf("failed to unmarshal test images: %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		imageName   string
+		nameRegex   *regexp.Regexp
+		location    string
+		expectedIDs []string
+	}{
+		{name: "available only", expectedIDs: []string{"img-5", "img-2", "img-4", "img-1"}},
+		{name: "by name", imageName: "golden-2024-01", expectedIDs: []string{"img-1"}},
+		{name: "by regex", nameRegex: regexp.MustCompile("^golden-"), expectedIDs: []string{"img-2", "img-4", "img-1"}},
+		{name: "by regex and location", nameRegex: regexp.MustCompile("^golden-"), location: "nl-1", expectedIDs: []string{"img-2", "img-1"}},
+		{name: "not yet available", imageName: "golden-2024-04", expectedIDs: []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := filterImages(images, tc.imageName, tc.nameRegex, tc.location)
+			if len(matches) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d matches, got %d", len(tc.expectedIDs), len(matches))
+			}
+			for i, id := range tc.expectedIDs {
+				if matches[i]["id"] != id {
+					t.Errorf("match %d: expected image %s, got %v", i, id, matches[i]["id"])
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceS3BucketMirrorsResource(t *testing.T) {
+	dataSource := dataSourceS3Bucket()
+	resource := resourceS3Bucket()
+
+	for field := range resource.Schema {
+		if _, ok := dataSource.Schema[field]; !ok {
+			t.Errorf("resource attribute %q not exposed by the hostman_s3_bucket data source", field)
+		}
+	}
+
+	for _, field := range []string{"access_key", "secret_key"} {
+		if !resource.Schema[field].Sensitive || !dataSource.Schema[field].Sensitive {
+			t.Errorf("expected field %q to be sensitive", field)
+		}
+	}
+}
+
+func TestFlattenS3Bucket(t *testing.T) {
+	var bucket map[string]interface{}
+	err := json.Unmarshal([]byte(`{"id": 42, "name": "tf-state", "type": "private", "preset_id": 1789, "location": "nl-1", "hostname": "s3.hostman.com", "status": "created"}`), &bucket)
+	if err != nil {
+		t.Fatalf("failed to unmarshal test bucket: %v", err)
+	}
+
+	attrs := flattenS3Bucket(bucket)
+	expected := map[string]interface{}{
+		"name":      "tf-state",
+		"type":      "private",
+		"preset_id": 1789,
+		"location":  "nl-1",
+		"endpoint":  "https://s3.hostman.com",
+		"status":    "created",
+	}
+	for key, value := range expected {
+		if attrs[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, attrs[key])
+		}
+	}
+}
+
+func TestFlattenProjectResources(t *testing.T) {
+	var resp map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"servers": [{"id": 10, "name": "app-2"}, {"id": 9, "name": "app-1"}],
+		"clusters": [{"id": 42, "name": "prod"}],
+		"floating_ips": [{"id": "ip-1", "ip": "203.0.113.10"}],
+		"dedicated_servers": [{"id": 7, "name": "legacy"}]
+	}`), &resp)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	result := flattenProjectResources(resp, "")
+	expected := []map[string]string{
+		{"type": "clusters", "id": "42", "name": "prod"},
+		{"type": "floating_ips", "id": "ip-1", "name": "203.0.113.10"},
+		{"type": "servers", "id": "9", "name": "app-1"},
+		{"type": "servers", "id": "10", "name": "app-2"},
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d resources, got %d", len(expected), len(result))
+	}
+	for i, e := range expected {
+		r := result[i].(map[string]interface{})
+		for key, value := range e {
+			if r[key] != value {
+				t.Errorf("resource %d: expected %s %q, got %q", i, key, value, r[key])
+			}
+		}
+	}
+
+	if servers := flattenProjectResources(resp, "servers"); len(servers) != 2 {
+		t.Errorf("expected 2 servers, got %d", len(servers))
+	}
+	if databases := flattenProjectResources(resp, "databases"); len(databases) != 0 {
+		t.Errorf("expected no databases, got %d", len(databases))
+	}
+}
@@ -27,9 +27,11 @@ resource "hostman_ip" "test-ip" {
   depends_on        = [hostman_server.test-server]
 }
 
+data "hostman_k8s_versions" "available" {}
+
 resource "hostman_kubernetes" "test-cluster" {
   name              = "test-k8s-cluster"
-  k8s_version       = "1.28"
+  k8s_version       = data.hostman_k8s_versions.available.latest
   network_driver    = "flannel"
   availability_zone = "ams-1"
 }
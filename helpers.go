@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Helper to convert an ID returned by the API to a string.
+// Large integer IDs are decoded as float64 by encoding/json.
+func idToString(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return fmt.Sprintf("%.0f", id)
+	case int:
+		return strconv.Itoa(id)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", id)
+	}
+}
+
+// Helper to check whether two CIDR blocks share any addresses
+func cidrsOverlap(a, b string) (bool, error) {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP), nil
+}
+
+// Helper to check whether the API responded with 404 Not Found
+func isNotFoundError(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Helper to check whether the API rejected the token
+func isAuthError(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// Helper to poll a resource URL until the API reports it as gone.
+// Only a 404 counts as deleted; authentication errors fail immediately and
+// any other error (5xx, rate limiting, network failures) is retried until maxWait.
+func waitForDeletion(url, token string, maxWait, interval time.Duration) error {
+	start := time.Now()
+	var lastErr error
+
+	for {
+		if time.Since(start) > maxWait {
+			if lastErr != nil {
+				return fmt.Errorf("timeout waiting for deletion, last error: %w", lastErr)
+			}
+			return fmt.Errorf("timeout waiting for deletion")
+		}
+
+		_, err := makeRequest("GET", url, token, nil)
+		switch {
+		case err == nil:
+			// Resource still exists
+		case isNotFoundError(err):
+			return nil
+		case isAuthError(err):
+			return err
+		default:
+			lastErr = err
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+var versionNumberRegexp = regexp.MustCompile(`\d+`)
+
+// Helper to compare two version strings such as "24.04" or "v1.28.0+k0s.0".
+// Numeric components are compared in order; returns -1, 0 or 1.
+func compareVersions(a, b string) int {
+	partsA := versionNumberRegexp.FindAllString(a, -1)
+	partsB := versionNumberRegexp.FindAllString(b, -1)
+
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numA, _ := strconv.Atoi(partsA[i])
+		numB, _ := strconv.Atoi(partsB[i])
+		if numA != numB {
+			if numA < numB {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(partsA) < len(partsB):
+		return -1
+	case len(partsA) > len(partsB):
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Helper to read a top-level boolean argument that is only meaningful when set
+// explicitly in the configuration, so that false is not confused with unset.
+func getOptionalBool(d *schema.ResourceData, key string) (value bool, ok bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false, false
+	}
+	v := config.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return false, false
+	}
+	return v.True(), true
+}
+
+// Helper to check whether a top-level argument is set in the configuration,
+// as opposed to a computed value carried over in the state.
+func isSetInConfig(d *schema.ResourceData, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
+
+// Helper to convert a set of strings into a sorted slice
+func expandStringSet(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Helper to check whether a slice contains a string
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper to convert a JSON array of strings into a string slice, skipping other values
+func flattenStringList(v interface{}) []string {
+	result := []string{}
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
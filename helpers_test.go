@@ -2,7 +2,12 @@ package main
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -96,3 +101,276 @@ func TestGetResourceIDString(t *testing.T) {
 		})
 	}
 }
+
+func TestIDToString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "string value",
+			value:    "abc-123",
+			expected: "abc-123",
+		},
+		{
+			name:     "float64 value",
+			value:    1234567.0,
+			expected: "1234567",
+		},
+		{
+			name:     "int value",
+			value:    42,
+			expected: "42",
+		},
+		{
+			name:     "nil value",
+			value:    nil,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := idToString(tc.value)
+			if result != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	testCases := []struct {
+		name      string
+		a         string
+		b         string
+		expected  bool
+		expectErr bool
+	}{
+		{
+			name:     "disjoint ranges",
+			a:        "10.96.0.0/12",
+			b:        "10.244.0.0/16",
+			expected: false,
+		},
+		{
+			name:     "nested ranges",
+			a:        "10.0.0.0/8",
+			b:        "10.244.0.0/16",
+			expected: true,
+		},
+		{
+			name:     "nested ranges reversed",
+			a:        "10.244.0.0/16",
+			b:        "10.0.0.0/8",
+			expected: true,
+		},
+		{
+			name:     "identical ranges",
+			a:        "192.168.0.0/24",
+			b:        "192.168.0.0/24",
+			expected: true,
+		},
+		{
+			name:      "invalid CIDR",
+			a:         "10.0.0.0",
+			b:         "10.0.0.0/8",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := cidrsOverlap(tc.a, tc.b)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedNF   bool
+		expectedAuth bool
+	}{
+		{
+			name:       "not found",
+			err:        &apiError{StatusCode: http.StatusNotFound, Body: "not found"},
+			expectedNF: true,
+		},
+		{
+			name:         "unauthorized",
+			err:          &apiError{StatusCode: http.StatusUnauthorized, Body: "unauthorized"},
+			expectedAuth: true,
+		},
+		{
+			name:         "forbidden",
+			err:          &apiError{StatusCode: http.StatusForbidden, Body: "forbidden"},
+			expectedAuth: true,
+		},
+		{
+			name: "server error",
+			err:  &apiError{StatusCode: http.StatusInternalServerError, Body: "internal server error"},
+		},
+		{
+			name:       "wrapped not found",
+			err:        fmt.Errorf("read failed: %w", &apiError{StatusCode: http.StatusNotFound}),
+			expectedNF: true,
+		},
+		{
+			name: "network error",
+			err:  errors.New("dial tcp: connection refused"),
+		},
+		{
+			name: "nil error",
+			err:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := isNotFoundError(tc.err); result != tc.expectedNF {
+				t.Errorf("isNotFoundError: expected %v, got %v", tc.expectedNF, result)
+			}
+			if result := isAuthError(tc.err); result != tc.expectedAuth {
+				t.Errorf("isAuthError: expected %v, got %v", tc.expectedAuth, result)
+			}
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := &apiError{StatusCode: http.StatusBadRequest, Body: "floating_ip_already_bound"}
+	if err.Error() != "API error (400): floating_ip_already_bound" {
+		t.Errorf("unexpected error message: %q", err.Error())
+	}
+	if !isAlreadyBoundError(err) {
+		t.Error("expected isAlreadyBoundError to match apiError")
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	testCases := []struct {
+		name      string
+		responses []int
+		expectErr bool
+	}{
+		{
+			name:      "deleted after polling",
+			responses: []int{http.StatusOK, http.StatusOK, http.StatusNotFound},
+			expectErr: false,
+		},
+		{
+			name:      "transient errors are retried",
+			responses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNotFound},
+			expectErr: false,
+		},
+		{
+			name:      "auth error fails immediately",
+			responses: []int{http.StatusOK, http.StatusUnauthorized, http.StatusNotFound},
+			expectErr: true,
+		},
+		{
+			name:      "resource never disappears",
+			responses: []int{http.StatusOK},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&calls, 1)) - 1
+				if n >= len(tc.responses) {
+					n = len(tc.responses) - 1
+				}
+				w.WriteHeader(tc.responses[n])
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			err := waitForDeletion(server.URL, "test-token", 200*time.Millisecond, 10*time.Millisecond)
+			if tc.expectErr && err == nil {
+				t.Fatal("expected error, but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"24.04", "22.04", 1},
+		{"20.04", "22.04", -1},
+		{"12", "11", 1},
+		{"12", "12", 0},
+		{"v1.28.0+k0s.0", "v1.27.5+k0s.0", 1},
+		{"v1.9.0", "v1.10.0", -1},
+		{"1.28", "1.28.1", -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"_vs_"+tc.b, func(t *testing.T) {
+			if result := compareVersions(tc.a, tc.b); result != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestGetOptionalBoolWithoutRawConfig(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"flag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+
+	// ResourceData built outside of a Terraform run has no raw config
+	data := resource.TestResourceData()
+	if value, ok := getOptionalBool(data, "flag"); ok || value {
+		t.Errorf("expected unset flag, got value=%v ok=%v", value, ok)
+	}
+}
+
+func TestStringHelpers(t *testing.T) {
+	set := schema.NewSet(schema.HashString, []interface{}{"b", "c", "a"})
+	sorted := expandStringSet(set)
+	if len(sorted) != 3 || sorted[0] != "a" || sorted[1] != "b" || sorted[2] != "c" {
+		t.Errorf("expected sorted set values, got %v", sorted)
+	}
+
+	if !containsString(sorted, "b") || containsString(sorted, "d") {
+		t.Errorf("unexpected containsString result for %v", sorted)
+	}
+
+	flattened := flattenStringList([]interface{}{"GET", 42, "PUT", nil})
+	if len(flattened) != 2 || flattened[0] != "GET" || flattened[1] != "PUT" {
+		t.Errorf("expected only string values, got %v", flattened)
+	}
+	if list := flattenStringList(nil); list == nil || len(list) != 0 {
+		t.Errorf("expected empty list for nil input, got %v", list)
+	}
+}
@@ -86,8 +86,8 @@ func TestTerraformConfigParsing(t *testing.T) {
 			// Note: We can't fully test without API but we can validate schema
 			resources := provider.ResourcesMap
 
-			if len(resources) != 3 {
-				t.Errorf("expected 3 resources, got %d", len(resources))
+			if len(resources) != 26 {
+				t.Errorf("expected 26 resources, got %d", len(resources))
 			}
 
 			if _, ok := resources["hostman_server"]; !ok {
@@ -101,6 +101,24 @@ func TestTerraformConfigParsing(t *testing.T) {
 			if _, ok := resources["hostman_kubernetes"]; !ok {
 				t.Error("hostman_kubernetes resource not found")
 			}
+
+			if _, ok := resources["hostman_k8s_addon"]; !ok {
+				t.Error("hostman_k8s_addon resource not found")
+			}
+
+			if _, ok := resources["hostman_image"]; !ok {
+				t.Error("hostman_image resource not found")
+			}
+
+			for _, name := range []string{"hostman_server_backup_schedule", "hostman_server_backup", "hostman_server_disk", "hostman_vpc",
+				"hostman_firewall_group", "hostman_firewall_rule", "hostman_firewall_attachment",
+				"hostman_load_balancer", "hostman_database_cluster", "hostman_database_instance", "hostman_database_user",
+				"hostman_s3_bucket", "hostman_s3_bucket_cors", "hostman_s3_bucket_lifecycle", "hostman_s3_bucket_subdomain",
+				"hostman_domain", "hostman_dns_record", "hostman_project", "hostman_container_registry", "hostman_k8s_registry", "hostman_app"} {
+				if _, ok := resources[name]; !ok {
+					t.Errorf("%s resource not found", name)
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigCredentials holds the connection details extracted from a kubeconfig,
+// in the form expected by the kubernetes and helm providers.
+type kubeconfigCredentials struct {
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+}
+
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// Helper to extract the kubeconfig from a /kubeconfig response body.
+// The endpoint either returns JSON with a kubeconfig field or the raw YAML document.
+func extractKubeconfig(body []byte) string {
+	kubeconfigStr := string(body)
+	if kubeconfigStr == "" || kubeconfigStr == "null" || kubeconfigStr == "{}" {
+		return ""
+	}
+
+	// Check if it's a JSON response with kubeconfig field
+	var kubeconfigResp map[string]interface{}
+	if err := json.Unmarshal(body, &kubeconfigResp); err == nil {
+		if kubeconfig, ok := kubeconfigResp["kubeconfig"].(string); ok {
+			return kubeconfig
+		}
+		return ""
+	}
+
+	// If not JSON, treat as raw kubeconfig content
+	return kubeconfigStr
+}
+
+// Helper to parse a kubeconfig and return the credentials of its current context.
+// Falls back to the first cluster and user when no current context is set.
+func parseKubeconfig(raw string) (*kubeconfigCredentials, error) {
+	var config kubeconfigFile
+	if err := yaml.Unmarshal([]byte(raw), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	if len(config.Clusters) == 0 {
+		return nil, fmt.Errorf("kubeconfig does not contain any clusters")
+	}
+
+	clusterName := config.Clusters[0].Name
+	userName := ""
+	if len(config.Users) > 0 {
+		userName = config.Users[0].Name
+	}
+	for _, c := range config.Contexts {
+		if c.Name == config.CurrentContext {
+			clusterName = c.Context.Cluster
+			userName = c.Context.User
+			break
+		}
+	}
+
+	creds := &kubeconfigCredentials{}
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		creds.Host = c.Cluster.Server
+		ca, err := decodeKubeconfigData(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode certificate-authority-data: %w", err)
+		}
+		creds.ClusterCACertificate = ca
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		cert, err := decodeKubeconfigData(u.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode client-certificate-data: %w", err)
+		}
+		key, err := decodeKubeconfigData(u.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode client-key-data: %w", err)
+		}
+		creds.ClientCertificate = cert
+		creds.ClientKey = key
+		creds.Token = u.User.Token
+	}
+
+	return creds, nil
+}
+
+// Helper to decode the base64 *-data fields of a kubeconfig into PEM
+func decodeKubeconfigData(data string) (string, error) {
+	data = strings.TrimSpace(data)
+	if data == "" {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+// Helper to read the expiry time of a PEM-encoded certificate
+func certificateExpiry(certPEM string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return time.Time{}, fmt.Errorf("no PEM data found in certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+// Helper to fetch a cluster's kubeconfig from the dedicated endpoint and set the
+// kubeconfig and credential attributes. Failures are reported as warnings so that
+// the rest of the cluster state is still refreshed, but stale credentials are noticed.
+func readKubeconfig(d *schema.ResourceData, token, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	kubeconfigBody, kubeconfigErr := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/kubeconfig", id), token, nil)
+	if kubeconfigErr != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to fetch kubeconfig",
+			Detail:   fmt.Sprintf("The kubeconfig for cluster %s could not be refreshed, the values in state may be stale: %s", id, kubeconfigErr),
+		})
+		return diags
+	}
+
+	kubeconfig := extractKubeconfig(kubeconfigBody)
+	if kubeconfig == "" {
+		return diags
+	}
+	d.Set("kubeconfig", kubeconfig)
+
+	creds, err := parseKubeconfig(kubeconfig)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to parse kubeconfig",
+			Detail:   fmt.Sprintf("The kubeconfig for cluster %s could not be parsed: %s", id, err),
+		})
+		return diags
+	}
+	d.Set("host", creds.Host)
+	d.Set("cluster_ca_certificate", creds.ClusterCACertificate)
+	d.Set("client_certificate", creds.ClientCertificate)
+	d.Set("client_key", creds.ClientKey)
+	d.Set("token", creds.Token)
+
+	expiresAt := ""
+	if creds.ClientCertificate != "" {
+		expiry, err := certificateExpiry(creds.ClientCertificate)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to parse client certificate",
+				Detail:   fmt.Sprintf("The expiry of the client certificate for cluster %s could not be determined: %s", id, err),
+			})
+		} else {
+			expiresAt = expiry.UTC().Format(time.RFC3339)
+			if time.Now().After(expiry) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Kubeconfig credentials have expired",
+					Detail:   fmt.Sprintf("The client certificate for cluster %s expired at %s. Change rotate_credentials_trigger on the hostman_kubernetes resource to rotate the credentials.", id, expiresAt),
+				})
+			}
+		}
+	}
+	d.Set("client_certificate_expires_at", expiresAt)
+
+	return diags
+}
+
+// Helper to poll a /kubeconfig URL until it returns credentials that differ from
+// the previous client certificate and token, so that a rotation is not reported
+// as done while the API still serves the old kubeconfig. Authentication errors
+// fail immediately; other errors are retried until maxWait.
+func waitForKubeconfigRotation(url, token, oldCert, oldToken string, maxWait, interval time.Duration) error {
+	start := time.Now()
+	var lastErr error
+
+	for {
+		if time.Since(start) > maxWait {
+			if lastErr != nil {
+				return fmt.Errorf("timeout waiting for rotated credentials, last error: %w", lastErr)
+			}
+			return fmt.Errorf("timeout waiting for rotated credentials")
+		}
+
+		body, err := makeRequest("GET", url, token, nil)
+		switch {
+		case err == nil:
+			if kubeconfig := extractKubeconfig(body); kubeconfig != "" {
+				creds, err := parseKubeconfig(kubeconfig)
+				if err != nil {
+					lastErr = err
+				} else if (creds.ClientCertificate != "" || creds.Token != "") &&
+					(creds.ClientCertificate != oldCert || creds.Token != oldToken) {
+					return nil
+				}
+			}
+		case isAuthError(err):
+			return err
+		default:
+			lastErr = err
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -82,6 +82,101 @@ func TestResourceEndpointsValidation(t *testing.T) {
 			resource:        resourceIP(),
 			expectedPattern: "floating-ips",
 		},
+		{
+			name:            "k8s_addon_resource",
+			resource:        resourceK8sAddon(),
+			expectedPattern: "k8s/clusters/{cluster_id}/addons",
+		},
+		{
+			name:            "image_resource",
+			resource:        resourceImage(),
+			expectedPattern: "images",
+		},
+		{
+			name:            "server_backup_schedule_resource",
+			resource:        resourceServerBackupSchedule(),
+			expectedPattern: "servers/{server_id}/disks/{disk_id}/auto-backups",
+		},
+		{
+			name:            "server_backup_resource",
+			resource:        resourceServerBackup(),
+			expectedPattern: "servers/{server_id}/disks/{disk_id}/backups",
+		},
+		{
+			name:            "server_disk_resource",
+			resource:        resourceServerDisk(),
+			expectedPattern: "servers/{server_id}/disks",
+		},
+		{
+			name:            "vpc_resource",
+			resource:        resourceVPC(),
+			expectedPattern: "vpcs",
+		},
+		{
+			name:            "firewall_group_resource",
+			resource:        resourceFirewallGroup(),
+			expectedPattern: "firewall/groups",
+		},
+		{
+			name:            "firewall_rule_resource",
+			resource:        resourceFirewallRule(),
+			expectedPattern: "firewall/groups/{group_id}/rules",
+		},
+		{
+			name:            "load_balancer_resource",
+			resource:        resourceLoadBalancer(),
+			expectedPattern: "balancers",
+		},
+		{
+			name:            "database_cluster_resource",
+			resource:        resourceDatabaseCluster(),
+			expectedPattern: "databases",
+		},
+		{
+			name:            "database_instance_resource",
+			resource:        resourceDatabaseInstance(),
+			expectedPattern: "databases/{cluster_id}/instances",
+		},
+		{
+			name:            "database_user_resource",
+			resource:        resourceDatabaseUser(),
+			expectedPattern: "databases/{cluster_id}/admins",
+		},
+		{
+			name:            "s3_bucket_resource",
+			resource:        resourceS3Bucket(),
+			expectedPattern: "storages/buckets",
+		},
+		{
+			name:            "s3_bucket_cors_resource",
+			resource:        resourceS3BucketCORS(),
+			expectedPattern: "storages/buckets/{bucket_id}/cors",
+		},
+		{
+			name:            "s3_bucket_lifecycle_resource",
+			resource:        resourceS3BucketLifecycle(),
+			expectedPattern: "storages/buckets/{bucket_id}/lifecycle",
+		},
+		{
+			name:            "dns_record_resource",
+			resource:        resourceDNSRecord(),
+			expectedPattern: "domains/{domain}/dns-records",
+		},
+		{
+			name:            "project_resource",
+			resource:        resourceProject(),
+			expectedPattern: "projects",
+		},
+		{
+			name:            "container_registry_resource",
+			resource:        resourceContainerRegistry(),
+			expectedPattern: "container-registry",
+		},
+		{
+			name:            "app_resource",
+			resource:        resourceApp(),
+			expectedPattern: "apps",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -18,9 +18,49 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"hostman_server":     resourceServer(),
-			"hostman_ip":         resourceIP(),
-			"hostman_kubernetes": resourceKubernetes(),
+			"hostman_server":                 resourceServer(),
+			"hostman_ip":                     resourceIP(),
+			"hostman_kubernetes":             resourceKubernetes(),
+			"hostman_k8s_addon":              resourceK8sAddon(),
+			"hostman_image":                  resourceImage(),
+			"hostman_server_backup_schedule": resourceServerBackupSchedule(),
+			"hostman_server_backup":          resourceServerBackup(),
+			"hostman_server_disk":            resourceServerDisk(),
+			"hostman_vpc":                    resourceVPC(),
+			"hostman_firewall_group":         resourceFirewallGroup(),
+			"hostman_firewall_rule":          resourceFirewallRule(),
+			"hostman_firewall_attachment":    resourceFirewallAttachment(),
+			"hostman_load_balancer":          resourceLoadBalancer(),
+			"hostman_database_cluster":       resourceDatabaseCluster(),
+			"hostman_database_instance":      resourceDatabaseInstance(),
+			"hostman_database_user":          resourceDatabaseUser(),
+			"hostman_s3_bucket":              resourceS3Bucket(),
+			"hostman_s3_bucket_cors":         resourceS3BucketCORS(),
+			"hostman_s3_bucket_lifecycle":    resourceS3BucketLifecycle(),
+			"hostman_s3_bucket_subdomain":    resourceS3BucketSubdomain(),
+			"hostman_domain":                 resourceDomain(),
+			"hostman_dns_record":             resourceDNSRecord(),
+			"hostman_project":                resourceProject(),
+			"hostman_container_registry":     resourceContainerRegistry(),
+			"hostman_k8s_registry":           resourceK8sRegistry(),
+			"hostman_app":                    resourceApp(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"hostman_server_preset":       dataSourceServerPreset(),
+			"hostman_server_presets":      dataSourceServerPresets(),
+			"hostman_os":                  dataSourceOS(),
+			"hostman_server":              dataSourceServer(),
+			"hostman_servers":             dataSourceServers(),
+			"hostman_k8s_versions":        dataSourceK8sVersions(),
+			"hostman_k8s_network_drivers": dataSourceK8sNetworkDrivers(),
+			"hostman_k8s_presets":         dataSourceK8sPresets(),
+			"hostman_kubernetes":          dataSourceKubernetes(),
+			"hostman_ip":                  dataSourceIP(),
+			"hostman_ips":                 dataSourceIPs(),
+			"hostman_locations":           dataSourceLocations(),
+			"hostman_images":              dataSourceImages(),
+			"hostman_s3_bucket":           dataSourceS3Bucket(),
+			"hostman_project_resources":   dataSourceProjectResources(),
 		},
 		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			token := d.Get("token").(string)
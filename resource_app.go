@@ -0,0 +1,350 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Settings that only take effect after the app is deployed again
+var appDeploySettings = []string{"branch", "build_command", "run_command", "env_vars"}
+
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppCreate,
+		ReadContext:   resourceAppRead,
+		UpdateContext: resourceAppUpdate,
+		DeleteContext: resourceAppDelete,
+		CustomizeDiff: resourceAppCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the app",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"frontend", "backend"}, false),
+				Description:  "Type of the app: frontend (static site) or backend (long-running service)",
+			},
+			"framework": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Framework the app is built with (e.g., react, vue, django, express)",
+			},
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of the git repository to deploy from",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "main",
+				Description: "Branch to deploy",
+			},
+			"build_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command that builds the app (e.g., npm run build)",
+			},
+			"run_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command that starts a backend app (e.g., npm start). Required for backend apps",
+			},
+			"env_vars": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Environment variables available at build and run time",
+			},
+			"preset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "App preset ID, which sets the resources of the app",
+			},
+			"is_auto_deploy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to deploy automatically on every push to the branch",
+			},
+			"redeploy_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that deploys the app again from the latest commit of the branch whenever it changes",
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Domains the app is served on",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the app",
+			},
+		},
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := appPayload(d)
+	payload["type"] = d.Get("type").(string)
+	payload["framework"] = d.Get("framework").(string)
+	payload["repository_url"] = d.Get("repository").(string)
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/apps", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	app := resp["app"].(map[string]interface{})
+	d.SetId(idToString(app["id"]))
+
+	// The first deploy starts as soon as the app is created
+	if err := waitForAppActive(token, d.Id(), 30*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/apps/%s", d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The app was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	app := resp["app"].(map[string]interface{})
+	for _, key := range []string{"name", "type", "framework", "status"} {
+		value, _ := app[key].(string)
+		d.Set(key, value)
+	}
+	if repository, ok := app["repository_url"].(string); ok {
+		d.Set("repository", repository)
+	}
+	if branch, ok := app["branch_name"].(string); ok {
+		d.Set("branch", branch)
+	}
+	buildCommand, _ := app["build_cmd"].(string)
+	d.Set("build_command", buildCommand)
+	runCommand, _ := app["run_cmd"].(string)
+	d.Set("run_command", runCommand)
+	if presetID, ok := app["preset_id"].(float64); ok {
+		d.Set("preset_id", int(presetID))
+	}
+	isAutoDeploy, _ := app["is_auto_deploy"].(bool)
+	d.Set("is_auto_deploy", isAutoDeploy)
+	if envs, ok := app["envs"].(map[string]interface{}); ok {
+		d.Set("env_vars", envs)
+	}
+	d.Set("domains", flattenAppDomains(app["domains"]))
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	if d.HasChanges("name", "branch", "build_command", "run_command", "env_vars", "preset_id", "is_auto_deploy") {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/apps/%s", id), token, appPayload(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges(appDeploySettings...) || d.HasChange("redeploy_trigger") {
+		body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/apps/%s/deploy", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+
+		deploy := resp["deploy"].(map[string]interface{})
+		if err := waitForAppDeploy(token, id, idToString(deploy["id"]), 30*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/apps/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for app %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAppCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The command may come from a resource that does not exist yet
+	if !d.NewValueKnown("run_command") {
+		return nil
+	}
+
+	return validateAppRunCommand(d.Get("type").(string), d.Get("run_command").(string))
+}
+
+// Helper to check that only backend apps have, and must have, a run command
+func validateAppRunCommand(appType, runCommand string) error {
+	switch {
+	case appType == "backend" && runCommand == "":
+		return fmt.Errorf("run_command must be set for backend apps")
+	case appType == "frontend" && runCommand != "":
+		return fmt.Errorf("run_command can only be set for backend apps, frontend apps are served as static files")
+	}
+	return nil
+}
+
+// Helper to build the updatable app settings from the resource configuration
+func appPayload(d *schema.ResourceData) map[string]interface{} {
+	envs := map[string]string{}
+	for key, value := range d.Get("env_vars").(map[string]interface{}) {
+		envs[key] = value.(string)
+	}
+
+	return map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"branch_name":    d.Get("branch").(string),
+		"build_cmd":      d.Get("build_command").(string),
+		"run_cmd":        d.Get("run_command").(string),
+		"envs":           envs,
+		"preset_id":      d.Get("preset_id").(int),
+		"is_auto_deploy": d.Get("is_auto_deploy").(bool),
+	}
+}
+
+// Helper to convert the domains of an app API object into a list of names
+func flattenAppDomains(v interface{}) []string {
+	result := []string{}
+	domains, _ := v.([]interface{})
+	for _, item := range domains {
+		if domain, ok := item.(map[string]interface{}); ok {
+			if fqdn, ok := domain["fqdn"].(string); ok && fqdn != "" {
+				result = append(result, fqdn)
+			}
+		}
+	}
+	return result
+}
+
+// Helper to poll an app until its first deploy has finished
+func waitForAppActive(token, id string, maxWait time.Duration) error {
+	interval := 15 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for app %s to deploy", id)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/apps/%s", id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		app, _ := resp["app"].(map[string]interface{})
+		status, _ := app["status"].(string)
+		switch status {
+		case "active":
+			return nil
+		case "failed", "error":
+			return fmt.Errorf("app %s failed to deploy with status: %s", id, status)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Helper to poll a single deploy of an app until it has finished.
+// Polling the deploy rather than the app avoids reporting the previous deploy as done.
+func waitForAppDeploy(token, appID, deployID string, maxWait time.Duration) error {
+	interval := 15 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for deploy %s of app %s", deployID, appID)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/apps/%s/deploys/%s", appID, deployID), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		deploy, _ := resp["deploy"].(map[string]interface{})
+		status, _ := deploy["status"].(string)
+		switch status {
+		case "success":
+			return nil
+		case "failed", "error":
+			return fmt.Errorf("deploy %s of app %s failed with status: %s", deployID, appID, status)
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerRegistryCreate,
+		ReadContext:   resourceContainerRegistryRead,
+		UpdateContext: resourceContainerRegistryUpdate,
+		DeleteContext: resourceContainerRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the registry. It is part of the registry URL",
+			},
+			"preset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Registry preset ID, which sets the storage size",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Location of the registry (e.g., nl-1)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the registry",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Host name to push and pull images with (e.g., myregistry.registry.hostman.com)",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User name to log in to the registry with",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password to log in to the registry with",
+			},
+			"docker_config_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Docker config JSON with the registry credentials, for a Kubernetes image pull secret of type kubernetes.io/dockerconfigjson",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the registry",
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"preset_id": d.Get("preset_id").(int),
+	}
+	if location := d.Get("location").(string); location != "" {
+		payload["location"] = location
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/container-registry", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	registry := resp["registry"].(map[string]interface{})
+	d.SetId(idToString(registry["id"]))
+
+	if err := waitForContainerRegistryStarted(token, d.Id(), 0, 20*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceContainerRegistryRead(ctx, d, meta)
+}
+
+func resourceContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/container-registry/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The registry was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	registry := resp["registry"].(map[string]interface{})
+	for _, key := range []string{"name", "location", "description", "status"} {
+		value, _ := registry[key].(string)
+		d.Set(key, value)
+	}
+	if presetID, ok := registry["preset_id"].(float64); ok {
+		d.Set("preset_id", int(presetID))
+	}
+	url, _ := registry["hostname"].(string)
+	d.Set("url", url)
+
+	username, password, err := fetchContainerRegistryCredentials(token, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("username", username)
+	d.Set("password", password)
+
+	dockerConfig, err := registryDockerConfigJSON(url, username, password)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("docker_config_json", dockerConfig)
+
+	return nil
+}
+
+func resourceContainerRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("preset_id") {
+		changes["preset_id"] = d.Get("preset_id").(int)
+	}
+	if d.HasChange("description") {
+		changes["description"] = d.Get("description").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/container-registry/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+	}
+
+	// A new preset is applied asynchronously; the registry still reports started with the old one right after the request
+	if d.HasChange("preset_id") {
+		if err := waitForContainerRegistryStarted(token, id, d.Get("preset_id").(int), 20*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceContainerRegistryRead(ctx, d, meta)
+}
+
+func resourceContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/container-registry/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for container registry %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to check whether a registry API object is started with the given preset.
+// A presetID of 0 only checks the status.
+func containerRegistryReady(registry map[string]interface{}, presetID int) (bool, error) {
+	status, _ := registry["status"].(string)
+	switch status {
+	case "started":
+		reported, _ := registry["preset_id"].(float64)
+		return presetID == 0 || int(reported) == presetID, nil
+	case "failed", "error":
+		return false, fmt.Errorf("failed with status: %s", status)
+	}
+	return false, nil
+}
+
+// Helper to poll a registry until it is started, with the given preset if presetID is not 0
+func waitForContainerRegistryStarted(token, id string, presetID int, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for container registry %s to start", id)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/container-registry/%s", id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		registry, _ := resp["registry"].(map[string]interface{})
+		ready, err := containerRegistryReady(registry, presetID)
+		if err != nil {
+			return fmt.Errorf("container registry %s %w", id, err)
+		}
+		if ready {
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Helper to fetch the login credentials of a registry
+func fetchContainerRegistryCredentials(token, id string) (username, password string, err error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/container-registry/%s/credentials", id), token, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	var resp struct {
+		Credentials struct {
+			Login    string `json:"login"`
+			Password string `json:"password"`
+		} `json:"credentials"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", "", err
+	}
+
+	return resp.Credentials.Login, resp.Credentials.Password, nil
+}
+
+// Helper to build the .dockerconfigjson content for a registry, as used by
+// Kubernetes image pull secrets. Returns an empty string until the host is known.
+func registryDockerConfigJSON(host, username, password string) (string, error) {
+	if host == "" {
+		return "", nil
+	}
+
+	config := map[string]interface{}{
+		"auths": map[string]interface{}{
+			host: map[string]interface{}{
+				"username": username,
+				"password": password,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
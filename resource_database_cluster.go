@@ -0,0 +1,407 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDatabaseCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseClusterCreate,
+		ReadContext:   resourceDatabaseClusterRead,
+		UpdateContext: resourceDatabaseClusterUpdate,
+		DeleteContext: resourceDatabaseClusterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the database cluster",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Database engine (e.g., postgres, mysql)",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database engine version (e.g., 16 for PostgreSQL, 8.0 for MySQL). Defaults to the latest version",
+			},
+			"preset_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"preset_id", "configuration"},
+				Description:  "Database preset ID. Cannot be provided together with configuration.",
+			},
+			"configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"preset_id", "configuration"},
+				Description:  "Database node configuration parameters. Cannot be provided together with preset_id.",
+				ConfigMode:   schema.SchemaConfigModeBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configurator_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Configurator ID",
+						},
+						"disk": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Disk size in GB",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Number of CPU cores",
+						},
+						"ram": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "RAM size in MB",
+						},
+					},
+				},
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the private network (VPC) to place the cluster in",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Location of the cluster (e.g., nl-1)",
+			},
+			"replication": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replication settings of the cluster",
+				ConfigMode:  schema.SchemaConfigModeBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+							Description:  "Number of replicas in addition to the primary node",
+						},
+					},
+				},
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address to connect to the cluster",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port to connect to the cluster",
+			},
+			"local_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Private address of the cluster in the VPC, if network_id is set",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the cluster",
+			},
+		},
+	}
+}
+
+func resourceDatabaseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"type": d.Get("type").(string),
+	}
+	if version := d.Get("version").(string); version != "" {
+		payload["version"] = version
+	}
+	if presetID := d.Get("preset_id").(int); presetID > 0 {
+		payload["preset_id"] = presetID
+	}
+	if configuration := expandDatabaseConfiguration(d.Get("configuration").([]interface{})); configuration != nil {
+		payload["configurator"] = configuration
+	}
+	if networkID := d.Get("network_id").(string); networkID != "" {
+		payload["network"] = map[string]interface{}{
+			"id": networkID,
+		}
+	}
+	if location := d.Get("location").(string); location != "" {
+		payload["location"] = location
+	}
+	if replication := expandDatabaseReplication(d.Get("replication").([]interface{})); replication != nil {
+		payload["replication"] = replication
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/databases", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	db := resp["db"].(map[string]interface{})
+	id := idToString(db["id"])
+	d.SetId(id)
+
+	if err := waitForDatabaseClusterStarted(token, id, nil, 30*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDatabaseClusterRead(ctx, d, meta)
+}
+
+func resourceDatabaseClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/databases/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The cluster was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	db := resp["db"].(map[string]interface{})
+	d.Set("name", db["name"])
+	d.Set("status", db["status"])
+
+	for _, key := range []string{"type", "version", "location", "host", "local_ip"} {
+		if value, ok := db[key].(string); ok {
+			d.Set(key, value)
+		}
+	}
+	if port, ok := db["port"].(float64); ok {
+		d.Set("port", int(port))
+	}
+	// The API may report both a preset and a configurator, but only one of them is configured
+	usesPreset := databaseClusterUsesPreset(d, db)
+	if presetID, ok := db["preset_id"].(float64); ok && usesPreset {
+		d.Set("preset_id", int(presetID))
+	}
+	if configuration, ok := db["configurator"].(map[string]interface{}); ok && !usesPreset {
+		configList := []interface{}{
+			map[string]interface{}{
+				"configurator_id": int(configuration["id"].(float64)),
+				"disk":            int(configuration["disk"].(float64)),
+				"cpu":             int(configuration["cpu"].(float64)),
+				"ram":             int(configuration["ram"].(float64)),
+			},
+		}
+		d.Set("configuration", configList)
+	}
+	if network, ok := db["network"].(map[string]interface{}); ok {
+		d.Set("network_id", idToString(network["id"]))
+	}
+	if replication, ok := db["replication"].(map[string]interface{}); ok {
+		if count, ok := replication["count"].(float64); ok && count > 0 {
+			d.Set("replication", []interface{}{
+				map[string]interface{}{
+					"count": int(count),
+				},
+			})
+		} else {
+			d.Set("replication", nil)
+		}
+	}
+
+	return nil
+}
+
+func resourceDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("name") {
+		changes["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("preset_id") {
+		if presetID := d.Get("preset_id").(int); presetID > 0 {
+			changes["preset_id"] = presetID
+		}
+	}
+	if d.HasChange("configuration") {
+		if configuration := expandDatabaseConfiguration(d.Get("configuration").([]interface{})); configuration != nil {
+			changes["configurator"] = configuration
+		}
+	}
+	if d.HasChange("replication") {
+		replication := expandDatabaseReplication(d.Get("replication").([]interface{}))
+		if replication == nil {
+			// Removing the block turns replication off
+			replication = map[string]interface{}{
+				"count": 0,
+			}
+		}
+		changes["replication"] = replication
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/databases/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+	}
+
+	// Resizing restarts the cluster, which still reports started with the old sizing right after the request
+	if d.HasChanges("preset_id", "configuration", "replication") {
+		if err := waitForDatabaseClusterStarted(token, id, changes, 30*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatabaseClusterRead(ctx, d, meta)
+}
+
+func resourceDatabaseClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/databases/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 15*time.Minute, 10*time.Second); err != nil {
+		return diag.Errorf("error waiting for database cluster %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to decide whether a cluster is sized by preset_id or by configuration.
+// The choice already in state wins; on import, a reported preset wins over a configurator.
+func databaseClusterUsesPreset(d *schema.ResourceData, db map[string]interface{}) bool {
+	if len(d.Get("configuration").([]interface{})) > 0 {
+		return false
+	}
+	if d.Get("preset_id").(int) > 0 {
+		return true
+	}
+	presetID, _ := db["preset_id"].(float64)
+	return presetID > 0
+}
+
+// Helper to convert the configuration block into the API configurator object
+func expandDatabaseConfiguration(configList []interface{}) map[string]interface{} {
+	if len(configList) == 0 || configList[0] == nil {
+		return nil
+	}
+	configMap := configList[0].(map[string]interface{})
+	return map[string]interface{}{
+		"id":   configMap["configurator_id"].(int),
+		"disk": configMap["disk"].(int),
+		"cpu":  configMap["cpu"].(int),
+		"ram":  configMap["ram"].(int),
+	}
+}
+
+// Helper to convert the replication block into the API replication object
+func expandDatabaseReplication(replicationList []interface{}) map[string]interface{} {
+	if len(replicationList) == 0 || replicationList[0] == nil {
+		return nil
+	}
+	replicationMap := replicationList[0].(map[string]interface{})
+	return map[string]interface{}{
+		"count": replicationMap["count"].(int),
+	}
+}
+
+// Helper to check whether a cluster reports the sizing requested in a PATCH
+func databaseClusterMatches(db, changes map[string]interface{}) bool {
+	if presetID, ok := changes["preset_id"].(int); ok {
+		if reported, _ := db["preset_id"].(float64); int(reported) != presetID {
+			return false
+		}
+	}
+	if configuration, ok := changes["configurator"].(map[string]interface{}); ok {
+		reported, _ := db["configurator"].(map[string]interface{})
+		for _, key := range []string{"id", "disk", "cpu", "ram"} {
+			if value, _ := reported[key].(float64); int(value) != configuration[key].(int) {
+				return false
+			}
+		}
+	}
+	if replication, ok := changes["replication"].(map[string]interface{}); ok {
+		reported, _ := db["replication"].(map[string]interface{})
+		if count, _ := reported["count"].(float64); int(count) != replication["count"].(int) {
+			return false
+		}
+	}
+	return true
+}
+
+// Helper to poll a database cluster until it is started and reports the requested changes
+func waitForDatabaseClusterStarted(token, id string, changes map[string]interface{}, maxWait time.Duration) error {
+	interval := 15 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for database cluster %s to start", id)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/databases/%s", id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		db, _ := resp["db"].(map[string]interface{})
+		status, _ := db["status"].(string)
+		switch status {
+		case "started":
+			if databaseClusterMatches(db, changes) {
+				return nil
+			}
+		case "failed", "error":
+			return fmt.Errorf("database cluster %s failed with status: %s", id, status)
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabaseInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseInstanceCreate,
+		ReadContext:   resourceDatabaseInstanceRead,
+		UpdateContext: resourceDatabaseInstanceUpdate,
+		DeleteContext: resourceDatabaseInstanceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database cluster the database belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the database",
+			},
+		},
+	}
+}
+
+func resourceDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/instances", clusterID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance := resp["instance"].(map[string]interface{})
+	d.SetId(idToString(instance["id"]))
+
+	return resourceDatabaseInstanceRead(ctx, d, meta)
+}
+
+func resourceDatabaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/instances/%s", clusterID, d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The database or its cluster was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance := resp["instance"].(map[string]interface{})
+	d.Set("name", instance["name"])
+	if description, ok := instance["description"].(string); ok {
+		d.Set("description", description)
+	}
+
+	return nil
+}
+
+func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	if d.HasChange("description") {
+		changes := map[string]interface{}{
+			"description": d.Get("description").(string),
+		}
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/instances/%s", clusterID, d.Id()), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatabaseInstanceRead(ctx, d, meta)
+}
+
+func resourceDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/instances/%s", clusterID, d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseUserCreate,
+		ReadContext:   resourceDatabaseUserRead,
+		UpdateContext: resourceDatabaseUserUpdate,
+		DeleteContext: resourceDatabaseUserDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database cluster the user belongs to",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Login of the user",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%",
+				ForceNew:    true,
+				Description: "Host the user may connect from (MySQL only, e.g., % or 192.168.0.%)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the user",
+			},
+			"privileges": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Privileges of the user per database",
+				ConfigMode:  schema.SchemaConfigModeBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the database (hostman_database_instance) the privileges apply to",
+						},
+						"privileges": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Privileges granted on the database (e.g., SELECT, INSERT, UPDATE, DELETE, CREATE, DROP)",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatabaseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	payload := map[string]interface{}{
+		"login":     d.Get("login").(string),
+		"password":  d.Get("password").(string),
+		"host":      d.Get("host").(string),
+		"instances": expandDatabaseUserPrivileges(d.Get("privileges").(*schema.Set).List()),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/admins", clusterID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	admin := resp["admin"].(map[string]interface{})
+	d.SetId(idToString(admin["id"]))
+
+	return resourceDatabaseUserRead(ctx, d, meta)
+}
+
+func resourceDatabaseUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/admins/%s", clusterID, d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The user or its cluster was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The password is never returned by the API, so the configured value is kept
+	admin := resp["admin"].(map[string]interface{})
+	d.Set("login", admin["login"])
+	if host, ok := admin["host"].(string); ok && host != "" {
+		d.Set("host", host)
+	}
+	if description, ok := admin["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if instances, ok := admin["instances"].([]interface{}); ok {
+		d.Set("privileges", flattenDatabaseUserPrivileges(instances))
+	}
+
+	return nil
+}
+
+func resourceDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	changes := make(map[string]interface{})
+	if d.HasChange("password") {
+		changes["password"] = d.Get("password").(string)
+	}
+	if d.HasChange("description") {
+		changes["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("privileges") {
+		changes["instances"] = expandDatabaseUserPrivileges(d.Get("privileges").(*schema.Set).List())
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/admins/%s", clusterID, d.Id()), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatabaseUserRead(ctx, d, meta)
+}
+
+func resourceDatabaseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/databases/%s/admins/%s", clusterID, d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to convert privileges blocks into the API instances list
+func expandDatabaseUserPrivileges(list []interface{}) []map[string]interface{} {
+	instances := make([]map[string]interface{}, 0, len(list))
+	for _, p := range list {
+		grant, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		instances = append(instances, map[string]interface{}{
+			"instance_id": grant["instance_id"].(string),
+			"privileges":  expandStringSet(grant["privileges"].(*schema.Set)),
+		})
+	}
+	return instances
+}
+
+// Helper to convert the API instances list into privileges blocks
+func flattenDatabaseUserPrivileges(instances []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(instances))
+	for _, i := range instances {
+		instance, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"instance_id": idToString(instance["instance_id"]),
+			"privileges":  flattenStringList(instance["privileges"]),
+		})
+	}
+	return result
+}
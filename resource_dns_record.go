@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSRecordCreate,
+		ReadContext:   resourceDNSRecordRead,
+		UpdateContext: resourceDNSRecordUpdate,
+		DeleteContext: resourceDNSRecordDelete,
+		CustomizeDiff: resourceDNSRecordCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Domain the record belongs to (e.g., hostman_domain.example.fqdn)",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "CAA"}, false),
+				Description:  "Record type: A, AAAA, CNAME, MX, TXT, SRV or CAA",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Subdomain the record is created for (e.g., www or _sip._tcp). The record is created for the domain itself if not set",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Record value: an address for A and AAAA, a host name for CNAME, MX and SRV, text for TXT, or flags, tag and value for CAA (e.g., 0 issue \"letsencrypt.org\")",
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "Time to live of the record in seconds",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Priority of MX and SRV records",
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Weight of SRV records",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Target port of SRV records",
+			},
+		},
+	}
+}
+
+func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	domain := d.Get("domain").(string)
+
+	payload := dnsRecordPayload(d)
+	payload["type"] = d.Get("type").(string)
+	if subdomain := d.Get("subdomain").(string); subdomain != "" {
+		payload["subdomain"] = subdomain
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/domains/%s/dns-records", domain), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	record := resp["dns_record"].(map[string]interface{})
+	d.SetId(idToString(record["id"]))
+
+	return resourceDNSRecordRead(ctx, d, meta)
+}
+
+func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	domain := d.Get("domain").(string)
+
+	record, err := findDNSRecord(token, domain, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			// The domain was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if record == nil {
+		// The record was deleted outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	for _, key := range []string{"type", "subdomain", "value"} {
+		value, _ := record[key].(string)
+		d.Set(key, value)
+	}
+	for _, key := range []string{"ttl", "priority", "weight", "port"} {
+		if value, ok := record[key].(float64); ok {
+			d.Set(key, int(value))
+		}
+	}
+
+	return nil
+}
+
+func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	domain := d.Get("domain").(string)
+
+	if d.HasChanges("value", "ttl", "priority", "weight", "port") {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/domains/%s/dns-records/%s", domain, d.Id()), token, dnsRecordPayload(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDNSRecordRead(ctx, d, meta)
+}
+
+func resourceDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	domain := d.Get("domain").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/domains/%s/dns-records/%s", domain, d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSRecordImport accepts IDs in the form domain/record_id
+func resourceDNSRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected domain/record_id (e.g., example.com/12345)", d.Id())
+	}
+
+	d.Set("domain", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDNSRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The value may come from a resource that does not exist yet, e.g. hostman_ip.ip
+	value := ""
+	if d.NewValueKnown("value") {
+		value = d.Get("value").(string)
+	}
+
+	return validateDNSRecord(d.Get("type").(string), value, d.Get("priority").(int), d.Get("weight").(int), d.Get("port").(int))
+}
+
+// Helper to check that the record settings match the record type.
+// An empty value is not checked, since it may not be known yet.
+func validateDNSRecord(recordType, value string, priority, weight, port int) error {
+	if value != "" {
+		ip := net.ParseIP(value)
+		switch recordType {
+		case "A":
+			if ip == nil || ip.To4() == nil {
+				return fmt.Errorf("value of an A record must be an IPv4 address, got %q", value)
+			}
+		case "AAAA":
+			if ip == nil || ip.To4() != nil {
+				return fmt.Errorf("value of an AAAA record must be an IPv6 address, got %q", value)
+			}
+		}
+	}
+
+	if recordType != "MX" && recordType != "SRV" && priority != 0 {
+		return fmt.Errorf("priority can only be set for MX and SRV records")
+	}
+	if recordType != "SRV" && (weight != 0 || port != 0) {
+		return fmt.Errorf("weight and port can only be set for SRV records")
+	}
+	if recordType == "SRV" && port == 0 {
+		return fmt.Errorf("port must be set for SRV records")
+	}
+
+	return nil
+}
+
+// Helper to build the updatable record settings from the resource configuration
+func dnsRecordPayload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"value": d.Get("value").(string),
+		"ttl":   d.Get("ttl").(int),
+	}
+	recordType := d.Get("type").(string)
+	if recordType == "MX" || recordType == "SRV" {
+		payload["priority"] = d.Get("priority").(int)
+	}
+	if recordType == "SRV" {
+		payload["weight"] = d.Get("weight").(int)
+		payload["port"] = d.Get("port").(int)
+	}
+	return payload
+}
+
+// Helper to look up a record in the domain's record list.
+// Returns nil without an error when the record does not exist.
+func findDNSRecord(token, domain, id string) (map[string]interface{}, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/domains/%s/dns-records", domain), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	records, _ := resp["dns_records"].([]interface{})
+	for _, r := range records {
+		record, ok := r.(map[string]interface{})
+		if ok && idToString(record["id"]) == id {
+			return record, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainCreate,
+		ReadContext:   resourceDomainRead,
+		DeleteContext: resourceDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Fully qualified domain name of the zone (e.g., example.com)",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the domain",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Registration expiry date, if the domain is registered through Hostman",
+			},
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	fqdn := d.Get("fqdn").(string)
+
+	payload := map[string]interface{}{
+		"fqdn": fqdn,
+	}
+	_, err := makeRequest("POST", "https://hostman.com/api/v1/domains", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Domains are addressed by name, which also keeps record import IDs readable
+	d.SetId(fqdn)
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	fqdn := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/domains/%s", fqdn), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The domain was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := resp["domain"].(map[string]interface{})
+	d.Set("fqdn", fqdn)
+	if status, ok := domain["domain_status"].(string); ok {
+		d.Set("status", status)
+	}
+	if expiration, ok := domain["expiration"].(string); ok {
+		d.Set("expiration", expiration)
+	}
+
+	return nil
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/domains/%s", d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
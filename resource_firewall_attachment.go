@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFirewallAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallAttachmentCreate,
+		ReadContext:   resourceFirewallAttachmentRead,
+		DeleteContext: resourceFirewallAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the firewall group",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"server", "balancer", "dbaas"}, false),
+				Description:  "Type of the protected resource: server, balancer (load balancer) or dbaas (database)",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the protected resource",
+			},
+		},
+	}
+}
+
+func resourceFirewallAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/resources/%s?resource_type=%s", groupID, resourceID, resourceType), token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", groupID, resourceType, resourceID))
+
+	return resourceFirewallAttachmentRead(ctx, d, meta)
+}
+
+func resourceFirewallAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/resources", groupID), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The group was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resources, _ := resp["resources"].([]interface{})
+	for _, r := range resources {
+		resource, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if resource["type"] == d.Get("resource_type").(string) && idToString(resource["id"]) == d.Get("resource_id").(string) {
+			return nil
+		}
+	}
+
+	// The resource was detached outside of Terraform
+	d.SetId("")
+	return nil
+}
+
+func resourceFirewallAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/resources/%s?resource_type=%s", groupID, resourceID, resourceType), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFirewallGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallGroupCreate,
+		ReadContext:   resourceFirewallGroupRead,
+		UpdateContext: resourceFirewallGroupUpdate,
+		DeleteContext: resourceFirewallGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the firewall group",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the firewall group",
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DROP",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DROP", "ACCEPT"}, false),
+				Description:  "Policy for traffic not matched by any rule: DROP or ACCEPT",
+			},
+		},
+	}
+}
+
+func resourceFirewallGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups?policy=%s", d.Get("policy").(string)), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := resp["group"].(map[string]interface{})
+	d.SetId(idToString(group["id"]))
+
+	return resourceFirewallGroupRead(ctx, d, meta)
+}
+
+func resourceFirewallGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The group was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := resp["group"].(map[string]interface{})
+	d.Set("name", group["name"])
+	if description, ok := group["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if policy, ok := group["policy"].(string); ok {
+		d.Set("policy", policy)
+	}
+
+	return nil
+}
+
+func resourceFirewallGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("name") {
+		changes["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		changes["description"] = d.Get("description").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFirewallGroupRead(ctx, d, meta)
+}
+
+func resourceFirewallGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 5*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for firewall group %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallRuleCreate,
+		ReadContext:   resourceFirewallRuleRead,
+		UpdateContext: resourceFirewallRuleUpdate,
+		DeleteContext: resourceFirewallRuleDelete,
+		CustomizeDiff: resourceFirewallRuleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the firewall group the rule belongs to",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+				Description:  "Traffic direction: ingress or egress",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp"}, false),
+				Description:  "Protocol: tcp, udp or icmp",
+			},
+			"port": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFirewallPort,
+				Description:  "Port or port range (e.g., 22 or 8000-9000). All ports if not set. Not allowed for icmp",
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Source (ingress) or destination (egress) network in CIDR notation. Any address if not set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the rule",
+			},
+		},
+	}
+}
+
+func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/rules", groupID), token, firewallRulePayload(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := resp["rule"].(map[string]interface{})
+	d.SetId(idToString(rule["id"]))
+
+	return resourceFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/rules/%s", groupID, d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The rule or its group was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Every attribute is refreshed so that edits made in the control panel show up as drift
+	rule := resp["rule"].(map[string]interface{})
+	for _, key := range []string{"direction", "protocol", "port", "cidr", "description"} {
+		value, _ := rule[key].(string)
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+
+	if d.HasChanges("direction", "protocol", "port", "cidr", "description") {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/rules/%s", groupID, d.Id()), token, firewallRulePayload(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	groupID := d.Get("group_id").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/firewall/groups/%s/rules/%s", groupID, d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceFirewallRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("protocol").(string) == "icmp" && d.Get("port").(string) != "" {
+		return fmt.Errorf("port cannot be set for icmp rules")
+	}
+
+	return nil
+}
+
+// Helper to build a firewall rule request from the resource configuration
+func firewallRulePayload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"direction": d.Get("direction").(string),
+		"protocol":  d.Get("protocol").(string),
+	}
+	for _, key := range []string{"port", "cidr", "description"} {
+		if value := d.Get(key).(string); value != "" {
+			payload[key] = value
+		}
+	}
+	return payload
+}
+
+// validateFirewallPort checks that a value is a single port or an ascending port range
+func validateFirewallPort(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+
+	bounds := strings.SplitN(value, "-", 2)
+	ports := make([]int, 0, len(bounds))
+	for _, b := range bounds {
+		port, err := strconv.Atoi(b)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, []error{fmt.Errorf("%s must be a port between 1 and 65535 or a range like 8000-9000, got %q", k, value)}
+		}
+		ports = append(ports, port)
+	}
+
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return nil, []error{fmt.Errorf("%s range must be ascending, got %q", k, value)}
+	}
+
+	return nil, nil
+}
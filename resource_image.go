@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageCreate,
+		ReadContext:   resourceImageRead,
+		UpdateContext: resourceImageUpdate,
+		DeleteContext: resourceImageDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the image",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the image",
+			},
+			"disk_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"disk_id", "upload_url"},
+				Description:  "ID of the server disk to create the image from (e.g., hostman_server.boot_disk_id)",
+			},
+			"upload_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"disk_id", "upload_url"},
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL to download the image from (qcow2, vmdk, vdi, vhd, img or raw)",
+			},
+			"os": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Operating system of the image (e.g., ubuntu, debian, windows)",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Location the image is stored in (e.g., nl-1)",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the image",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the image in MB",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the image",
+			},
+		},
+	}
+}
+
+func resourceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+	if diskID := d.Get("disk_id").(int); diskID != 0 {
+		payload["disk_id"] = diskID
+	}
+	if uploadURL := d.Get("upload_url").(string); uploadURL != "" {
+		payload["upload_url"] = uploadURL
+	}
+	if os := d.Get("os").(string); os != "" {
+		payload["os"] = os
+	}
+	if location := d.Get("location").(string); location != "" {
+		payload["location"] = location
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/images", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := resp["image"].(map[string]interface{})
+	id := idToString(image["id"])
+	d.SetId(id)
+
+	// Creating an image from a disk or downloading it can take a long time
+	if err := waitForImageReady(token, id, 60*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceImageRead(ctx, d, meta)
+}
+
+func resourceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/images/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The image was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := resp["image"].(map[string]interface{})
+	for key, value := range flattenImage(image) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("name") {
+		changes["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		changes["description"] = d.Get("description").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/images/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceImageRead(ctx, d, meta)
+}
+
+func resourceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/images/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for image %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to poll an image until it becomes available
+func waitForImageReady(token, id string, maxWait time.Duration) error {
+	interval := 15 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for image %s to become available", id)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/images/%s", id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		image, _ := resp["image"].(map[string]interface{})
+		status, _ := image["status"].(string)
+		if status == "failed" || status == "error" {
+			return fmt.Errorf("image %s creation failed with status: %s", id, status)
+		}
+		if isImageAvailable(status) {
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Helper to check whether an image status means the image can be used
+func isImageAvailable(status string) bool {
+	return status == "created" || status == "ready" || status == "available"
+}
+
+// Helper to convert an image API object into resource and data source attributes
+func flattenImage(image map[string]interface{}) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":        "",
+		"description": "",
+		"os":          "",
+		"location":    "",
+		"status":      "",
+		"size":        0,
+		"created_at":  "",
+	}
+
+	for _, key := range []string{"name", "description", "os", "location", "status", "created_at"} {
+		if value, ok := image[key].(string); ok {
+			attrs[key] = value
+		}
+	}
+	if size, ok := image["size"].(float64); ok {
+		attrs["size"] = int(size)
+	}
+
+	return attrs
+}
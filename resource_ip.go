@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,6 +46,12 @@ func resourceIP() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project to place the floating IP in. Changing this moves the floating IP without recreating it. The default project is used if not set",
+			},
 		},
 	}
 }
@@ -85,6 +92,12 @@ func resourceIPCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.SetId(id)
 	d.Set("ip", ip["ip"])
 
+	if projectID := d.Get("project_id").(string); projectID != "" {
+		if err := moveToProject(token, projectID, "floating_ips", id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Now bind if resource_type and resource_id are set
 	resourceType := d.Get("resource_type").(string)
 	resourceID := getResourceIDString(d)
@@ -127,6 +140,9 @@ func resourceIPRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	} else {
 		d.Set("resource_id", "")
 	}
+	if projectID, ok := ip["project_id"]; ok && projectID != nil {
+		d.Set("project_id", idToString(projectID))
+	}
 
 	return nil
 }
@@ -172,6 +188,14 @@ func resourceIPUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if d.HasChange("project_id") {
+		if projectID := d.Get("project_id").(string); projectID != "" {
+			if err := moveToProject(token, projectID, "floating_ips", id); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceIPRead(ctx, d, meta)
 }
 
@@ -199,11 +223,17 @@ func resourceIPDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 	token := meta.(string)
 	id := d.Id()
 
-	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/floating-ips/%s", id), token, nil)
-	if err != nil {
+	url := fmt.Sprintf("https://hostman.com/api/v1/floating-ips/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
 		return diag.FromErr(err)
 	}
 
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 5*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for floating IP %s deletion: %s", id, err)
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceK8sAddon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceK8sAddonCreate,
+		ReadContext:   resourceK8sAddonRead,
+		UpdateContext: resourceK8sAddonUpdate,
+		DeleteContext: resourceK8sAddonDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Kubernetes cluster the add-on is installed into",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Add-on type (e.g., cert-manager, metrics-server, csi-s3, nginx-ingress)",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Add-on version. Defaults to the latest version offered by the platform",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Add-on configuration in YAML format",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the add-on",
+			},
+		},
+	}
+}
+
+func resourceK8sAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+	addonType := d.Get("type").(string)
+
+	payload := map[string]interface{}{}
+	if version := d.Get("version").(string); version != "" {
+		payload["version"] = version
+	}
+	if config := d.Get("config").(string); config != "" {
+		payload["yaml_config"] = config
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/addons/%s", clusterID, addonType), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	addon := resp["addon"].(map[string]interface{})
+	id := idToString(addon["id"])
+	d.SetId(id)
+
+	if err := waitForK8sAddonReady(token, clusterID, id, 20*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceK8sAddonRead(ctx, d, meta)
+}
+
+func resourceK8sAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	addon, err := findK8sAddon(token, clusterID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if addon == nil {
+		// The add-on was uninstalled outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	if addonType, ok := addon["type"].(string); ok {
+		d.Set("type", addonType)
+	}
+	if version, ok := addon["version"].(string); ok {
+		d.Set("version", version)
+	}
+	if config, ok := addon["yaml_config"].(string); ok {
+		d.Set("config", config)
+	}
+	d.Set("status", addon["status"])
+
+	return nil
+}
+
+func resourceK8sAddonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("version") {
+		changes["version"] = d.Get("version").(string)
+	}
+	if d.HasChange("config") {
+		changes["yaml_config"] = d.Get("config").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/addons/%s", clusterID, id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForK8sAddonReady(token, clusterID, id, 20*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceK8sAddonRead(ctx, d, meta)
+}
+
+func resourceK8sAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+	id := d.Id()
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/addons/%s", clusterID, id), token, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Wait for the add-on to disappear from the cluster
+	maxWait := 15 * time.Minute
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return diag.Errorf("timeout waiting for add-on %s to be uninstalled", id)
+		}
+
+		addon, err := findK8sAddon(token, clusterID, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if addon == nil {
+			break
+		}
+
+		time.Sleep(interval)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to look up an add-on in the cluster's add-on list.
+// Returns nil without an error when the add-on is not installed.
+func findK8sAddon(token, clusterID, id string) (map[string]interface{}, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/addons", clusterID), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	addons, _ := resp["addons"].([]interface{})
+	for _, a := range addons {
+		addon, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if idToString(addon["id"]) == id {
+			return addon, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Helper to poll an add-on until it reports a ready status
+func waitForK8sAddonReady(token, clusterID, id string, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for add-on %s to become ready", id)
+		}
+
+		addon, err := findK8sAddon(token, clusterID, id)
+		if err != nil {
+			return err
+		}
+		// The add-on may not be listed right after the install request
+		status := ""
+		if addon != nil {
+			status, _ = addon["status"].(string)
+		}
+		switch status {
+		case "failed", "error":
+			return fmt.Errorf("add-on %s installation failed with status: %s", id, status)
+		case "installed", "ready", "started":
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
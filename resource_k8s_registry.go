@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceK8sRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceK8sRegistryCreate,
+		ReadContext:   resourceK8sRegistryRead,
+		DeleteContext: resourceK8sRegistryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Kubernetes cluster",
+			},
+			"registry_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the container registry to pull images from",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Namespace to create the image pull secret in. Its default service account uses the secret",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the image pull secret created in the namespace",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the registry connection",
+			},
+		},
+	}
+}
+
+func resourceK8sRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	payload := map[string]interface{}{
+		"registry_id": d.Get("registry_id").(string),
+		"namespace":   d.Get("namespace").(string),
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/registries", clusterID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	registry := resp["registry"].(map[string]interface{})
+	id := idToString(registry["id"])
+	d.SetId(id)
+
+	if err := waitForK8sRegistryReady(token, clusterID, id, 10*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceK8sRegistryRead(ctx, d, meta)
+}
+
+func resourceK8sRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	registry, err := findK8sRegistry(token, clusterID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			// The cluster was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if registry == nil {
+		// The registry was disconnected outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("registry_id", idToString(registry["registry_id"]))
+	if namespace, ok := registry["namespace"].(string); ok {
+		d.Set("namespace", namespace)
+	}
+	secretName, _ := registry["secret_name"].(string)
+	d.Set("secret_name", secretName)
+	d.Set("status", registry["status"])
+
+	return nil
+}
+
+func resourceK8sRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/registries/%s", clusterID, d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to look up a registry connection in the cluster's registry list.
+// Returns nil without an error when the registry is not connected.
+func findK8sRegistry(token, clusterID, id string) (map[string]interface{}, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/registries", clusterID), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	registries, _ := resp["registries"].([]interface{})
+	for _, r := range registries {
+		registry, ok := r.(map[string]interface{})
+		if ok && idToString(registry["id"]) == id {
+			return registry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Helper to poll a registry connection until the pull secret is in place
+func waitForK8sRegistryReady(token, clusterID, id string, maxWait time.Duration) error {
+	interval := 5 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for registry connection %s to become ready", id)
+		}
+
+		registry, err := findK8sRegistry(token, clusterID, id)
+		if err != nil {
+			return err
+		}
+		// The connection may not be listed right after the request
+		status := ""
+		if registry != nil {
+			status, _ = registry["status"].(string)
+		}
+		switch status {
+		case "failed", "error":
+			return fmt.Errorf("registry connection %s failed with status: %s", id, status)
+		case "ready", "active":
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
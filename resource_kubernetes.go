@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKubernetes() *schema.Resource {
@@ -17,6 +18,7 @@ func resourceKubernetes() *schema.Resource {
 		ReadContext:   resourceKubernetesRead,
 		UpdateContext: resourceKubernetesUpdate,
 		DeleteContext: resourceKubernetesDelete,
+		CustomizeDiff: resourceKubernetesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -209,6 +211,41 @@ func resourceKubernetes() *schema.Resource {
 				Default:     "ams-1",
 				Description: "Availability zone for the cluster",
 			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the private network (VPC) to place the cluster in",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project to place the cluster in. Changing this moves the cluster without recreating it. The default project is used if not set",
+			},
+			"pod_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "CIDR block for pod IP addresses. Must not overlap with service_subnet",
+			},
+			"service_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "CIDR block for service IP addresses. Must not overlap with pod_subnet",
+			},
+			"master_public_ip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the cluster API is reachable on a public IP address",
+			},
 			"cluster_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -225,6 +262,43 @@ func resourceKubernetes() *schema.Resource {
 				Sensitive:   true,
 				Description: "The kubeconfig for accessing the cluster",
 			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Kubernetes API server address from the kubeconfig",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM-encoded root certificate of the cluster",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM-encoded client certificate for TLS authentication",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client key for TLS authentication",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for authenticating to the cluster, if the kubeconfig uses one",
+			},
+			"client_certificate_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the client certificate in RFC 3339 format",
+			},
+			"rotate_credentials_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that rotates the cluster credentials whenever it changes",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -341,6 +415,24 @@ func resourceKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta
 		payload["availability_zone"] = availabilityZone
 	}
 
+	// Add networking options
+	if networkID := d.Get("network_id").(string); networkID != "" {
+		payload["network_id"] = networkID
+	}
+
+	if podSubnet := d.Get("pod_subnet").(string); podSubnet != "" {
+		payload["pod_subnet"] = podSubnet
+	}
+
+	if serviceSubnet := d.Get("service_subnet").(string); serviceSubnet != "" {
+		payload["service_subnet"] = serviceSubnet
+	}
+
+	// Only send master_public_ip when it is set explicitly, so that false is not confused with unset
+	if masterPublicIP, ok := getOptionalBool(d, "master_public_ip"); ok {
+		payload["master_public_ip"] = masterPublicIP
+	}
+
 	// Using /api/v1/k8s/clusters endpoint for Kubernetes cluster operations
 	body, err := makeRequest("POST", "https://hostman.com/api/v1/k8s/clusters", token, payload)
 	if err != nil {
@@ -368,6 +460,12 @@ func resourceKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(id)
 	d.Set("cluster_id", id)
 
+	if projectID := d.Get("project_id").(string); projectID != "" {
+		if err := moveToProject(token, projectID, "clusters", id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Wait for cluster to be ready and kubeconfig to be available
 	maxWait := 30 * time.Minute
 	interval := 10 * time.Second
@@ -477,60 +575,7 @@ func resourceKubernetesRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if workerGroups, ok := cluster["worker_groups"].([]interface{}); ok {
-		groups := make([]interface{}, 0, len(workerGroups))
-		
-		for _, wg := range workerGroups {
-			workerGroup := wg.(map[string]interface{})
-			group := map[string]interface{}{
-				"name":       workerGroup["name"].(string),
-				"node_count": int(workerGroup["node_count"].(float64)),
-			}
-
-			if presetId, ok := workerGroup["preset_id"].(float64); ok {
-				group["preset_id"] = int(presetId)
-			}
-
-			if configuration, ok := workerGroup["configuration"].(map[string]interface{}); ok {
-				configList := []interface{}{
-					map[string]interface{}{
-						"configurator_id": int(configuration["configurator_id"].(float64)),
-						"disk":           int(configuration["disk"].(float64)),
-						"cpu":            int(configuration["cpu"].(float64)),
-						"ram":            int(configuration["ram"].(float64)),
-					},
-				}
-				group["configuration"] = configList
-			}
-
-			if labels, ok := workerGroup["labels"].([]interface{}); ok {
-				labelsList := make([]interface{}, 0, len(labels))
-				for _, l := range labels {
-					labelMap := l.(map[string]interface{})
-					labelsList = append(labelsList, map[string]interface{}{
-						"key":   labelMap["key"].(string),
-						"value": labelMap["value"].(string),
-					})
-				}
-				group["labels"] = labelsList
-			}
-
-			// Only set autoscaling fields if they exist and have meaningful values
-			if isAutoscaling, ok := workerGroup["is_autoscaling"].(bool); ok && isAutoscaling {
-				group["is_autoscaling"] = isAutoscaling
-			}
-
-			if minSize, ok := workerGroup["min-size"].(float64); ok && minSize > 0 {
-				group["min_size"] = int(minSize)
-			}
-
-			if maxSize, ok := workerGroup["max-size"].(float64); ok && maxSize > 0 {
-				group["max_size"] = int(maxSize)
-			}
-
-			groups = append(groups, group)
-		}
-		
-		d.Set("worker_groups", groups)
+		d.Set("worker_groups", flattenK8sWorkerGroups(workerGroups))
 	}
 
 	if isIngress, ok := cluster["is_ingress"].(bool); ok {
@@ -545,32 +590,91 @@ func resourceKubernetesRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("availability_zone", availabilityZone)
 	}
 
+	if networkID, ok := cluster["network_id"]; ok && networkID != nil {
+		d.Set("network_id", idToString(networkID))
+	}
+
+	if projectID, ok := cluster["project_id"]; ok && projectID != nil {
+		d.Set("project_id", idToString(projectID))
+	}
+
+	if podSubnet, ok := cluster["pod_subnet"].(string); ok {
+		d.Set("pod_subnet", podSubnet)
+	}
+
+	if serviceSubnet, ok := cluster["service_subnet"].(string); ok {
+		d.Set("service_subnet", serviceSubnet)
+	}
+
+	if masterPublicIP, ok := cluster["master_public_ip"].(bool); ok {
+		d.Set("master_public_ip", masterPublicIP)
+	}
+
 	if endpoint, ok := cluster["endpoint"].(string); ok {
 		d.Set("endpoint", endpoint)
 	}
 
 	// Fetch kubeconfig from dedicated endpoint
-	kubeconfigBody, kubeconfigErr := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/kubeconfig", id), token, nil)
-	if kubeconfigErr == nil {
-		// Try to parse as direct kubeconfig string response first
-		kubeconfigStr := string(kubeconfigBody)
-		if kubeconfigStr != "" && kubeconfigStr != "null" && kubeconfigStr != "{}" {
-			// Check if it's a JSON response with kubeconfig field
-			var kubeconfigResp map[string]interface{}
-			if err := json.Unmarshal(kubeconfigBody, &kubeconfigResp); err == nil {
-				if kubeconfig, ok := kubeconfigResp["kubeconfig"].(string); ok && kubeconfig != "" {
-					d.Set("kubeconfig", kubeconfig)
-				}
-			} else {
-				// If not JSON, treat as raw kubeconfig content
-				if kubeconfigStr != "" {
-					d.Set("kubeconfig", kubeconfigStr)
-				}
+	return readKubeconfig(d, token, id)
+}
+
+// Helper to convert the worker groups of a cluster API object into schema values
+func flattenK8sWorkerGroups(workerGroups []interface{}) []interface{} {
+	groups := make([]interface{}, 0, len(workerGroups))
+	
+	for _, wg := range workerGroups {
+		workerGroup := wg.(map[string]interface{})
+		group := map[string]interface{}{
+			"name":       workerGroup["name"].(string),
+			"node_count": int(workerGroup["node_count"].(float64)),
+		}
+
+		if presetId, ok := workerGroup["preset_id"].(float64); ok {
+			group["preset_id"] = int(presetId)
+		}
+
+		if configuration, ok := workerGroup["configuration"].(map[string]interface{}); ok {
+			configList := []interface{}{
+				map[string]interface{}{
+					"configurator_id": int(configuration["configurator_id"].(float64)),
+					"disk":           int(configuration["disk"].(float64)),
+					"cpu":            int(configuration["cpu"].(float64)),
+					"ram":            int(configuration["ram"].(float64)),
+				},
+			}
+			group["configuration"] = configList
+		}
+
+		if labels, ok := workerGroup["labels"].([]interface{}); ok {
+			labelsList := make([]interface{}, 0, len(labels))
+			for _, l := range labels {
+				labelMap := l.(map[string]interface{})
+				labelsList = append(labelsList, map[string]interface{}{
+					"key":   labelMap["key"].(string),
+					"value": labelMap["value"].(string),
+				})
 			}
+			group["labels"] = labelsList
 		}
+
+		// Only set autoscaling fields if they exist and have meaningful values
+		if isAutoscaling, ok := workerGroup["is_autoscaling"].(bool); ok && isAutoscaling {
+			group["is_autoscaling"] = isAutoscaling
+		}
+
+		if minSize, ok := workerGroup["min-size"].(float64); ok && minSize > 0 {
+			group["min_size"] = int(minSize)
+		}
+
+		if maxSize, ok := workerGroup["max-size"].(float64); ok && maxSize > 0 {
+			group["max_size"] = int(maxSize)
+		}
+
+		groups = append(groups, group)
 	}
+	
 
-	return nil
+	return groups
 }
 
 func resourceKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -676,38 +780,67 @@ func resourceKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Rotate the cluster credentials whenever the trigger value changes
+	if d.HasChange("rotate_credentials_trigger") {
+		oldCert, _ := d.GetChange("client_certificate")
+		oldToken, _ := d.GetChange("token")
+
+		_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/kubeconfig/rotate", id), token, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Wait for the new credentials, otherwise Read may still store the old kubeconfig
+		url := fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s/kubeconfig", id)
+		if err := waitForKubeconfigRotation(url, token, oldCert.(string), oldToken.(string), 10*time.Minute, 5*time.Second); err != nil {
+			return diag.Errorf("error waiting for cluster %s credentials to rotate: %s", id, err)
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if projectID := d.Get("project_id").(string); projectID != "" {
+			if err := moveToProject(token, projectID, "clusters", id); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceKubernetesRead(ctx, d, meta)
 }
 
+func resourceKubernetesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	podSubnet := d.Get("pod_subnet").(string)
+	serviceSubnet := d.Get("service_subnet").(string)
+	if podSubnet == "" || serviceSubnet == "" {
+		return nil
+	}
+
+	overlap, err := cidrsOverlap(podSubnet, serviceSubnet)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return fmt.Errorf("pod_subnet %s overlaps with service_subnet %s", podSubnet, serviceSubnet)
+	}
+
+	return nil
+}
+
 func resourceKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	token := meta.(string)
 	id := d.Id()
 
-	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s", id), token, nil)
-	if err != nil {
+	url := fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
 		return diag.FromErr(err)
 	}
 
 	// Wait for deletion to complete
-	maxWait := 15 * time.Minute
-	interval := 10 * time.Second
-	start := time.Now()
-
-	for {
-		if time.Since(start) > maxWait {
-			return diag.Errorf("timeout waiting for cluster deletion")
-		}
-
-		// Check if cluster still exists
-		_, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/k8s/clusters/%s", id), token, nil)
-		if err != nil {
-			// If we get an error (likely 404), the cluster is deleted
-			break
-		}
-
-		time.Sleep(interval)
+	if err := waitForDeletion(url, token, 15*time.Minute, 10*time.Second); err != nil {
+		return diag.Errorf("error waiting for cluster %s deletion: %s", id, err)
 	}
 
 	d.SetId("")
 	return nil
-}
\ No newline at end of file
+}
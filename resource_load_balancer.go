@@ -0,0 +1,688 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// loadBalancerRule is a forwarding rule as returned by /balancers/{id}/rules
+type loadBalancerRule struct {
+	ID            int    `json:"id,omitempty"`
+	BalancerProto string `json:"balancer_proto"`
+	BalancerPort  int    `json:"balancer_port"`
+	ServerProto   string `json:"server_proto"`
+	ServerPort    int    `json:"server_port"`
+}
+
+// key identifies a rule by its settings, since rules cannot be changed in place
+func (r loadBalancerRule) key() string {
+	return fmt.Sprintf("%s:%d->%s:%d", r.BalancerProto, r.BalancerPort, r.ServerProto, r.ServerPort)
+}
+
+func resourceLoadBalancer() *schema.Resource {
+	protocols := []string{"http", "http2", "https", "tcp"}
+
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerCreate,
+		ReadContext:   resourceLoadBalancerRead,
+		UpdateContext: resourceLoadBalancerUpdate,
+		DeleteContext: resourceLoadBalancerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the load balancer",
+			},
+			"preset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Load balancer preset ID",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "roundrobin",
+				ValidateFunc: validation.StringInSlice([]string{"roundrobin", "leastconn"}, false),
+				Description:  "Balancing algorithm: roundrobin or leastconn",
+			},
+			"is_sticky": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether requests from the same client are sent to the same backend (sticky sessions)",
+			},
+			"is_use_proxy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the client address is passed to backends with the PROXY protocol",
+			},
+			"is_keepalive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether keepalive connections to backends are used",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Forwarding rules from the load balancer to the backends",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"balancer_proto": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(protocols, false),
+							Description:  "Protocol the load balancer listens on: http, http2, https or tcp",
+						},
+						"balancer_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port the load balancer listens on",
+						},
+						"server_proto": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(protocols, false),
+							Description:  "Protocol used to connect to the backends: http, http2, https or tcp",
+						},
+						"server_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port the backends listen on",
+						},
+					},
+				},
+			},
+			"health_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Health check settings for the backends",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"proto": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "tcp",
+							ValidateFunc: validation.StringInSlice(protocols, false),
+							Description:  "Protocol of the health check: http, http2, https or tcp",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      80,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Backend port the health check connects to",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "/",
+							Description: "Path requested by http and https health checks",
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Seconds between health checks",
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Seconds to wait for a health check response",
+						},
+						"fall": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Failed checks before a backend is marked unhealthy",
+						},
+						"rise": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Successful checks before a backend is marked healthy",
+						},
+					},
+				},
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP addresses of the backends",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"server_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of servers to use as backends. Their public IPv4 addresses are added to the load balancer",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"backend_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All backend IP addresses of the load balancer, including those of server_ids",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public IP address of the load balancer",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the load balancer",
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := loadBalancerPayload(d)
+	payload["preset_id"] = d.Get("preset_id").(int)
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/balancers", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	balancer := resp["balancer"].(map[string]interface{})
+	id := idToString(balancer["id"])
+	d.SetId(id)
+
+	if err := waitForLoadBalancerStarted(token, id, nil, 20*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := syncLoadBalancerRules(token, id, expandLoadBalancerRules(d.Get("rule").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ips, err := loadBalancerDesiredIPs(d, token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncLoadBalancerIPs(token, id, ips); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The load balancer was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	balancer := resp["balancer"].(map[string]interface{})
+	d.Set("name", balancer["name"])
+	d.Set("status", balancer["status"])
+	if ip, ok := balancer["ip"].(string); ok {
+		d.Set("ip", ip)
+	}
+	if presetID, ok := balancer["preset_id"].(float64); ok {
+		d.Set("preset_id", int(presetID))
+	}
+	if algorithm, ok := balancer["algo"].(string); ok {
+		d.Set("algorithm", algorithm)
+	}
+	for _, key := range []string{"is_sticky", "is_use_proxy", "is_keepalive"} {
+		if value, ok := balancer[key].(bool); ok {
+			d.Set(key, value)
+		}
+	}
+	d.Set("health_check", flattenLoadBalancerHealthCheck(balancer))
+
+	rules, err := fetchLoadBalancerRules(token, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("rule", flattenLoadBalancerRules(orderLoadBalancerRules(rules, expandLoadBalancerRules(d.Get("rule").([]interface{})))))
+
+	backendIPs, err := fetchLoadBalancerIPs(token, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("backend_ips", backendIPs)
+
+	// Servers that were deleted or lost their address show up as drift in ips instead of failing the refresh
+	serverIPs, err := resolveServerIPs(token, expandStringSet(d.Get("server_ids").(*schema.Set)), true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ips", loadBalancerStateIPs(backendIPs, serverIPs, expandStringSet(d.Get("ips").(*schema.Set))))
+
+	return nil
+}
+
+func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	if d.HasChanges("name", "preset_id", "algorithm", "is_sticky", "is_use_proxy", "is_keepalive", "health_check") {
+		changes := loadBalancerPayload(d)
+		if d.HasChange("preset_id") {
+			changes["preset_id"] = d.Get("preset_id").(int)
+		}
+
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The balancer still reports started with its old settings right after the request
+		if err := waitForLoadBalancerStarted(token, id, changes, 20*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("rule") {
+		if err := syncLoadBalancerRules(token, id, expandLoadBalancerRules(d.Get("rule").([]interface{}))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("ips", "server_ids") {
+		ips, err := loadBalancerDesiredIPs(d, token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := syncLoadBalancerIPs(token, id, ips); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/balancers/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for load balancer %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to build the load balancer settings request from the resource configuration
+func loadBalancerPayload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"algo":         d.Get("algorithm").(string),
+		"is_sticky":    d.Get("is_sticky").(bool),
+		"is_use_proxy": d.Get("is_use_proxy").(bool),
+		"is_keepalive": d.Get("is_keepalive").(bool),
+	}
+
+	// Health check settings are top-level fields in the API
+	if checks := d.Get("health_check").([]interface{}); len(checks) > 0 && checks[0] != nil {
+		check := checks[0].(map[string]interface{})
+		payload["proto"] = check["proto"]
+		payload["port"] = check["port"]
+		payload["path"] = check["path"]
+		payload["inter"] = check["interval"]
+		payload["timeout"] = check["timeout"]
+		payload["fall"] = check["fall"]
+		payload["rise"] = check["rise"]
+	}
+
+	return payload
+}
+
+// Helper to convert the top-level health check fields of a balancer API object into a health_check block
+func flattenLoadBalancerHealthCheck(balancer map[string]interface{}) []interface{} {
+	check := map[string]interface{}{}
+	if proto, ok := balancer["proto"].(string); ok {
+		check["proto"] = proto
+	}
+	if path, ok := balancer["path"].(string); ok {
+		check["path"] = path
+	}
+	for key, field := range map[string]string{"port": "port", "interval": "inter", "timeout": "timeout", "fall": "fall", "rise": "rise"} {
+		if value, ok := balancer[field].(float64); ok {
+			check[key] = int(value)
+		}
+	}
+	return []interface{}{check}
+}
+
+// Helper to convert rule blocks from the configuration into API rules
+func expandLoadBalancerRules(list []interface{}) []loadBalancerRule {
+	rules := make([]loadBalancerRule, 0, len(list))
+	for _, r := range list {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, loadBalancerRule{
+			BalancerProto: rule["balancer_proto"].(string),
+			BalancerPort:  rule["balancer_port"].(int),
+			ServerProto:   rule["server_proto"].(string),
+			ServerPort:    rule["server_port"].(int),
+		})
+	}
+	return rules
+}
+
+// Helper to convert API rules into rule blocks
+func flattenLoadBalancerRules(rules []loadBalancerRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"balancer_proto": rule.BalancerProto,
+			"balancer_port":  rule.BalancerPort,
+			"server_proto":   rule.ServerProto,
+			"server_port":    rule.ServerPort,
+		})
+	}
+	return result
+}
+
+// Helper to sort API rules in the order they are configured, so that the API
+// returning them in a different order is not reported as a change.
+// Rules that are not configured are appended at the end.
+func orderLoadBalancerRules(rules, configured []loadBalancerRule) []loadBalancerRule {
+	position := make(map[string]int, len(configured))
+	for i, rule := range configured {
+		if _, ok := position[rule.key()]; !ok {
+			position[rule.key()] = i
+		}
+	}
+
+	ordered := make([]loadBalancerRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, okI := position[ordered[i].key()]
+		pj, okJ := position[ordered[j].key()]
+		switch {
+		case okI && okJ:
+			return pi < pj
+		case okI != okJ:
+			return okI
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	return ordered
+}
+
+// Helper to fetch the forwarding rules of a load balancer
+func fetchLoadBalancerRules(token, id string) ([]loadBalancerRule, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s/rules", id), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Rules []loadBalancerRule `json:"rules"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Rules, nil
+}
+
+// Helper to work out which rules to delete and which to create to reach the desired rules
+func diffLoadBalancerRules(current, desired []loadBalancerRule) (remove, add []loadBalancerRule) {
+	wanted := make(map[string]bool, len(desired))
+	for _, rule := range desired {
+		wanted[rule.key()] = true
+	}
+	existing := make(map[string]bool, len(current))
+	for _, rule := range current {
+		existing[rule.key()] = true
+		if !wanted[rule.key()] {
+			remove = append(remove, rule)
+		}
+	}
+	for _, rule := range desired {
+		if !existing[rule.key()] {
+			add = append(add, rule)
+			existing[rule.key()] = true
+		}
+	}
+	return remove, add
+}
+
+// Helper to replace the forwarding rules of a load balancer with the desired rules
+func syncLoadBalancerRules(token, id string, desired []loadBalancerRule) error {
+	current, err := fetchLoadBalancerRules(token, id)
+	if err != nil {
+		return err
+	}
+
+	remove, add := diffLoadBalancerRules(current, desired)
+	for _, rule := range remove {
+		_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s/rules/%d", id, rule.ID), token, nil)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	for _, rule := range add {
+		_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s/rules", id), token, rule)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper to fetch the backend IP addresses of a load balancer
+func fetchLoadBalancerIPs(token, id string) ([]string, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s/ips", id), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		IPs []string `json:"ips"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(resp.IPs)
+	return resp.IPs, nil
+}
+
+// Helper to replace the backend IP addresses of a load balancer with the desired addresses
+func syncLoadBalancerIPs(token, id string, desired []string) error {
+	current, err := fetchLoadBalancerIPs(token, id)
+	if err != nil {
+		return err
+	}
+
+	var remove, add []string
+	for _, ip := range current {
+		if !containsString(desired, ip) {
+			remove = append(remove, ip)
+		}
+	}
+	for _, ip := range desired {
+		if !containsString(current, ip) {
+			add = append(add, ip)
+		}
+	}
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/balancers/%s/ips", id)
+	if len(remove) > 0 {
+		if _, err := makeRequest("DELETE", url, token, map[string]interface{}{"ips": remove}); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		if _, err := makeRequest("POST", url, token, map[string]interface{}{"ips": add}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper to combine ips with the addresses of server_ids into the desired backend list
+func loadBalancerDesiredIPs(d *schema.ResourceData, token string) ([]string, error) {
+	ips := expandStringSet(d.Get("ips").(*schema.Set))
+
+	serverIPs, err := resolveServerIPs(token, expandStringSet(d.Get("server_ids").(*schema.Set)), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range serverIPs {
+		if !containsString(ips, ip) {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips, nil
+}
+
+// Helper to split the backend addresses into the ips attribute. Addresses that belong
+// to server_ids are left out, unless they are also listed in the configured ips.
+func loadBalancerStateIPs(backendIPs, serverIPs, configuredIPs []string) []string {
+	ips := make([]string, 0, len(backendIPs))
+	for _, ip := range backendIPs {
+		if !containsString(serverIPs, ip) || containsString(configuredIPs, ip) {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// Helper to look up the public IPv4 addresses of servers.
+// With skipMissing, deleted servers and servers without a public IPv4 address are skipped.
+func resolveServerIPs(token string, serverIDs []string, skipMissing bool) ([]string, error) {
+	ips := make([]string, 0, len(serverIDs))
+	for _, serverID := range serverIDs {
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/servers/%s", serverID), token, nil)
+		if err != nil {
+			if skipMissing && isNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, err
+		}
+
+		server := resp["server"].(map[string]interface{})
+		ip := flattenServer(server)["ipv4"].(string)
+		if ip == "" {
+			if skipMissing {
+				continue
+			}
+			return nil, fmt.Errorf("server %s has no public IPv4 address", serverID)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// Helper to check whether a balancer API object reports the settings sent in a PATCH.
+// Settings the API does not report are not compared.
+func loadBalancerMatches(balancer, changes map[string]interface{}) bool {
+	for key, want := range changes {
+		reported, ok := balancer[key]
+		if !ok || reported == nil {
+			continue
+		}
+		// Numbers are decoded as float64 but sent as int
+		if number, ok := reported.(float64); ok {
+			reported = int(number)
+		}
+		if fmt.Sprint(reported) != fmt.Sprint(want) {
+			return false
+		}
+	}
+	return true
+}
+
+// Helper to poll a load balancer until it is started and reports the requested changes
+func waitForLoadBalancerStarted(token, id string, changes map[string]interface{}, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for load balancer %s to start", id)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/balancers/%s", id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		balancer, _ := resp["balancer"].(map[string]interface{})
+		status, _ := balancer["status"].(string)
+		switch status {
+		case "started":
+			if loadBalancerMatches(balancer, changes) {
+				return nil
+			}
+		case "failed", "error":
+			return fmt.Errorf("load balancer %s failed with status: %s", id, status)
+		}
+
+		time.Sleep(interval)
+	}
+}
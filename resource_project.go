@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the project",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the project",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this is the default project of the account, where new resources are placed",
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/projects", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := resp["project"].(map[string]interface{})
+	d.SetId(idToString(project["id"]))
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/projects/%s", d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The project was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := resp["project"].(map[string]interface{})
+	d.Set("name", project["name"])
+	description, _ := project["description"].(string)
+	d.Set("description", description)
+	isDefault, _ := project["is_default"].(bool)
+	d.Set("is_default", isDefault)
+
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	if d.HasChanges("name", "description") {
+		payload := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		_, err := makeRequest("PUT", fmt.Sprintf("https://hostman.com/api/v1/projects/%s", d.Id()), token, payload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/projects/%s", d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to move a resource into a project.
+// resourceType is the resource collection used by /projects/{id}/resources, e.g. servers.
+func moveToProject(token, projectID, resourceType, resourceID string) error {
+	payload := map[string]interface{}{
+		"resource_id": resourceID,
+	}
+	_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/projects/%s/resources/%s", projectID, resourceType), token, payload)
+	if err != nil {
+		return fmt.Errorf("error moving %s %s to project %s: %w", resourceType, resourceID, projectID, err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceS3Bucket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceS3BucketCreate,
+		ReadContext:   resourceS3BucketRead,
+		UpdateContext: resourceS3BucketUpdate,
+		DeleteContext: resourceS3BucketDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bucket",
+			},
+			"preset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Storage preset ID",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Description:  "Access type of the bucket: private or public",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Location of the bucket (e.g., nl-1)",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "S3 endpoint URL of the bucket",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "S3 access key",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "S3 secret key",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the bucket",
+			},
+		},
+	}
+}
+
+func resourceS3BucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"preset_id": d.Get("preset_id").(int),
+		"type":      d.Get("type").(string),
+	}
+	if location := d.Get("location").(string); location != "" {
+		payload["location"] = location
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/storages/buckets", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := resp["bucket"].(map[string]interface{})
+	d.SetId(idToString(bucket["id"]))
+
+	return resourceS3BucketRead(ctx, d, meta)
+}
+
+func resourceS3BucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The bucket was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := resp["bucket"].(map[string]interface{})
+	for key, value := range flattenS3Bucket(bucket) {
+		d.Set(key, value)
+	}
+
+	accessKey, secretKey, err := fetchS3Credentials(token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("access_key", accessKey)
+	d.Set("secret_key", secretKey)
+
+	return nil
+}
+
+func resourceS3BucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("preset_id") {
+		changes["preset_id"] = d.Get("preset_id").(int)
+	}
+	if d.HasChange("type") {
+		changes["type"] = d.Get("type").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceS3BucketRead(ctx, d, meta)
+}
+
+func resourceS3BucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 5*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for bucket %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to fetch the S3 credentials of the account. They are shared by all buckets.
+func fetchS3Credentials(token string) (accessKey, secretKey string, err error) {
+	body, err := makeRequest("GET", "https://hostman.com/api/v1/storages/users", token, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	var resp struct {
+		Users []struct {
+			AccessKey string `json:"access_key"`
+			SecretKey string `json:"secret_key"`
+		} `json:"users"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", "", err
+	}
+	if len(resp.Users) == 0 {
+		return "", "", fmt.Errorf("no S3 credentials found for the account")
+	}
+
+	return resp.Users[0].AccessKey, resp.Users[0].SecretKey, nil
+}
+
+// Helper to convert a bucket API object into resource and data source attributes
+func flattenS3Bucket(bucket map[string]interface{}) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":      "",
+		"preset_id": 0,
+		"type":      "",
+		"location":  "",
+		"endpoint":  "",
+		"status":    "",
+	}
+
+	for _, key := range []string{"name", "type", "location", "status"} {
+		if value, ok := bucket[key].(string); ok {
+			attrs[key] = value
+		}
+	}
+	if presetID, ok := bucket["preset_id"].(float64); ok {
+		attrs["preset_id"] = int(presetID)
+	}
+	if hostname, ok := bucket["hostname"].(string); ok && hostname != "" {
+		attrs["endpoint"] = "https://" + hostname
+	}
+
+	return attrs
+}
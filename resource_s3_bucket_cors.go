@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceS3BucketCORS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceS3BucketCORSPut,
+		ReadContext:   resourceS3BucketCORSRead,
+		UpdateContext: resourceS3BucketCORSPut,
+		DeleteContext: resourceS3BucketCORSDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the bucket",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "CORS rules of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_methods": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "HTTP methods allowed from the origins: GET, PUT, POST, DELETE or HEAD",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+							},
+						},
+						"allowed_origins": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Origins allowed to access the bucket (e.g., https://example.com or *)",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Request headers allowed in preflight requests",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Response headers exposed to the browser",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Seconds the browser may cache the preflight response",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceS3BucketCORSPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	bucketID := d.Get("bucket_id").(string)
+
+	rules := make([]map[string]interface{}, 0)
+	for _, r := range d.Get("cors_rule").([]interface{}) {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, map[string]interface{}{
+			"allowed_methods": expandStringSet(rule["allowed_methods"].(*schema.Set)),
+			"allowed_origins": expandStringSet(rule["allowed_origins"].(*schema.Set)),
+			"allowed_headers": expandStringSet(rule["allowed_headers"].(*schema.Set)),
+			"expose_headers":  expandStringSet(rule["expose_headers"].(*schema.Set)),
+			"max_age_seconds": rule["max_age_seconds"].(int),
+		})
+	}
+
+	// The whole CORS configuration is replaced at once
+	payload := map[string]interface{}{
+		"cors_rules": rules,
+	}
+	_, err := makeRequest("PUT", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/cors", bucketID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(bucketID)
+
+	return resourceS3BucketCORSRead(ctx, d, meta)
+}
+
+func resourceS3BucketCORSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/cors", d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The bucket or its CORS configuration was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, _ := resp["cors_rules"].([]interface{})
+	if len(list) == 0 {
+		// The CORS configuration was removed outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	rules := make([]interface{}, 0, len(list))
+	for _, r := range list {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		maxAge := 0
+		if v, ok := rule["max_age_seconds"].(float64); ok {
+			maxAge = int(v)
+		}
+		rules = append(rules, map[string]interface{}{
+			"allowed_methods": flattenStringList(rule["allowed_methods"]),
+			"allowed_origins": flattenStringList(rule["allowed_origins"]),
+			"allowed_headers": flattenStringList(rule["allowed_headers"]),
+			"expose_headers":  flattenStringList(rule["expose_headers"]),
+			"max_age_seconds": maxAge,
+		})
+	}
+
+	d.Set("bucket_id", d.Id())
+	d.Set("cors_rule", rules)
+
+	return nil
+}
+
+func resourceS3BucketCORSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/cors", d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceS3BucketLifecycle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceS3BucketLifecyclePut,
+		ReadContext:   resourceS3BucketLifecycleRead,
+		UpdateContext: resourceS3BucketLifecyclePut,
+		DeleteContext: resourceS3BucketLifecycleDelete,
+		CustomizeDiff: resourceS3BucketLifecycleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the bucket",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Lifecycle rules of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique name of the rule",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Only apply the rule to objects whose key starts with this prefix. Applies to all objects if not set",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the rule is applied",
+						},
+						"expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Days after creation when objects are deleted",
+						},
+						"noncurrent_version_expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Days after an object version becomes noncurrent when it is deleted",
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Days after initiation when incomplete multipart uploads are aborted",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Integer settings of a lifecycle rule. Zero means the setting is not used.
+var s3LifecycleDays = []string{"expiration_days", "noncurrent_version_expiration_days", "abort_incomplete_multipart_upload_days"}
+
+func resourceS3BucketLifecyclePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	bucketID := d.Get("bucket_id").(string)
+
+	rules := make([]map[string]interface{}, 0)
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		payload := map[string]interface{}{
+			"id":      rule["id"].(string),
+			"prefix":  rule["prefix"].(string),
+			"enabled": rule["enabled"].(bool),
+		}
+		for _, key := range s3LifecycleDays {
+			if days := rule[key].(int); days > 0 {
+				payload[key] = days
+			}
+		}
+		rules = append(rules, payload)
+	}
+
+	// The whole lifecycle configuration is replaced at once
+	payload := map[string]interface{}{
+		"rules": rules,
+	}
+	_, err := makeRequest("PUT", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/lifecycle", bucketID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(bucketID)
+
+	return resourceS3BucketLifecycleRead(ctx, d, meta)
+}
+
+func resourceS3BucketLifecycleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, r := range d.Get("rule").([]interface{}) {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		hasAction := false
+		for _, key := range s3LifecycleDays {
+			// The days may come from a resource that does not exist yet
+			if !d.NewValueKnown(fmt.Sprintf("rule.%d.%s", i, key)) || rule[key].(int) > 0 {
+				hasAction = true
+				break
+			}
+		}
+		if !hasAction {
+			return fmt.Errorf("rule %d (%s): at least one of %v must be set", i, rule["id"], s3LifecycleDays)
+		}
+	}
+
+	return nil
+}
+
+func resourceS3BucketLifecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/lifecycle", d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The bucket or its lifecycle configuration was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, _ := resp["rules"].([]interface{})
+	if len(list) == 0 {
+		// The lifecycle configuration was removed outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	rules := make([]interface{}, 0, len(list))
+	for _, r := range list {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		flattened := map[string]interface{}{
+			"id":      "",
+			"prefix":  "",
+			"enabled": false,
+		}
+		if id, ok := rule["id"].(string); ok {
+			flattened["id"] = id
+		}
+		if prefix, ok := rule["prefix"].(string); ok {
+			flattened["prefix"] = prefix
+		}
+		if enabled, ok := rule["enabled"].(bool); ok {
+			flattened["enabled"] = enabled
+		}
+		for _, key := range s3LifecycleDays {
+			flattened[key] = 0
+			if days, ok := rule[key].(float64); ok {
+				flattened[key] = int(days)
+			}
+		}
+		rules = append(rules, flattened)
+	}
+
+	d.Set("bucket_id", d.Id())
+	d.Set("rule", rules)
+
+	return nil
+}
+
+func resourceS3BucketLifecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/lifecycle", d.Id()), token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3BucketSubdomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceS3BucketSubdomainCreate,
+		ReadContext:   resourceS3BucketSubdomainRead,
+		DeleteContext: resourceS3BucketSubdomainDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the bucket",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Fully qualified domain name to serve the bucket from (e.g., static.example.com). It must have a CNAME record pointing to the bucket endpoint",
+			},
+			"issue_certificate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether to issue a TLS certificate for the subdomain and wait for it",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate status of the subdomain",
+			},
+		},
+	}
+}
+
+func resourceS3BucketSubdomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	bucketID := d.Get("bucket_id").(string)
+	subdomain := d.Get("subdomain").(string)
+
+	payload := map[string]interface{}{
+		"subdomains": []string{subdomain},
+	}
+	_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/subdomains", bucketID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucketID, subdomain))
+
+	if d.Get("issue_certificate").(bool) {
+		certPayload := map[string]interface{}{
+			"subdomain": subdomain,
+		}
+		_, err := makeRequest("POST", "https://hostman.com/api/v1/storages/certificates/generate", token, certPayload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForS3SubdomainCertificate(token, bucketID, subdomain, 15*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceS3BucketSubdomainRead(ctx, d, meta)
+}
+
+func resourceS3BucketSubdomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	entry, err := findS3Subdomain(token, d.Get("bucket_id").(string), d.Get("subdomain").(string))
+	if err != nil {
+		if isNotFoundError(err) {
+			// The bucket was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if entry == nil {
+		// The subdomain was removed outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("status", entry["status"])
+
+	return nil
+}
+
+func resourceS3BucketSubdomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"subdomains": []string{d.Get("subdomain").(string)},
+	}
+	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/subdomains", d.Get("bucket_id").(string)), token, payload)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to look up a subdomain in the bucket's subdomain list.
+// Returns nil without an error when the subdomain is not mapped.
+func findS3Subdomain(token, bucketID, subdomain string) (map[string]interface{}, error) {
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/storages/buckets/%s/subdomains", bucketID), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	subdomains, _ := resp["subdomains"].([]interface{})
+	for _, s := range subdomains {
+		entry, ok := s.(map[string]interface{})
+		if ok && entry["subdomain"] == subdomain {
+			return entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Helper to poll a subdomain until its certificate is issued
+func waitForS3SubdomainCertificate(token, bucketID, subdomain string, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for the certificate of %s to be issued", subdomain)
+		}
+
+		entry, err := findS3Subdomain(token, bucketID, subdomain)
+		if err != nil {
+			return err
+		}
+		status := ""
+		if entry != nil {
+			status, _ = entry["status"].(string)
+		}
+		switch status {
+		case "ssl_released":
+			return nil
+		case "failed":
+			return fmt.Errorf("certificate for %s could not be issued, check that its CNAME record points to the bucket endpoint", subdomain)
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceServer() *schema.Resource {
@@ -52,10 +53,44 @@ func resourceServer() *schema.Resource {
 				Sensitive:   true,
 				Description: "The root password for the server. Only available after creation.",
 			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the VPC to attach the server to",
+			},
+			"local_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"vpc_id"},
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "Private IPv4 address of the server in the VPC. Assigned automatically if not set.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project to place the server in. Changing this moves the server without recreating it. The default project is used if not set",
+			},
+			"boot_disk_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the system disk of the server. Can be used to create an image with hostman_image.",
+			},
 		},
 	}
 }
 
+// apiError is returned by makeRequest when the API responds with an error status code
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Body)
+}
+
 func makeRequest(method, url, token string, body interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 	if body != nil {
@@ -80,7 +115,7 @@ func makeRequest(method, url, token string, body interface{}) ([]byte, error) {
 
 	if resp.StatusCode >= 400 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+		return nil, &apiError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return io.ReadAll(resp.Body)
@@ -105,6 +140,16 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		payload["preset_id"] = presetID
 	}
 
+	if vpcID := d.Get("vpc_id").(string); vpcID != "" {
+		network := map[string]interface{}{
+			"id": vpcID,
+		}
+		if localIP := d.Get("local_ip").(string); localIP != "" {
+			network["ip"] = localIP
+		}
+		payload["network"] = network
+	}
+
 	body, err := makeRequest("POST", "https://hostman.com/api/v1/servers", token, payload)
 	if err != nil {
 		return diag.FromErr(err)
@@ -119,6 +164,12 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	id := int(server["id"].(float64))
 	d.SetId(strconv.Itoa(id))
 
+	if projectID := d.Get("project_id").(string); projectID != "" {
+		if err := moveToProject(token, projectID, "servers", d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Poll for root_pass to become available
 	var rootPass string
 	maxWait := 30 * time.Minute
@@ -167,11 +218,68 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	server := resp["server"].(map[string]interface{})
 	d.Set("name", server["name"])
 	d.Set("root_pass", server["root_pass"])
+	d.Set("boot_disk_id", serverBootDiskID(server))
+	vpcID, localIP := serverLocalNetwork(server)
+	d.Set("vpc_id", vpcID)
+	d.Set("local_ip", localIP)
+	if projectID, ok := server["project_id"]; ok && projectID != nil {
+		d.Set("project_id", idToString(projectID))
+	}
 	// Add more attributes as needed
 
 	return nil
 }
 
+// Helper to find the ID of the system disk in a server API object
+func serverBootDiskID(server map[string]interface{}) int {
+	disks, _ := server["disks"].([]interface{})
+	for _, d := range disks {
+		disk, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isSystem, _ := disk["is_system"].(bool); isSystem {
+			if id, ok := disk["id"].(float64); ok {
+				return int(id)
+			}
+		}
+	}
+	return 0
+}
+
+// Helper to build the payload that attaches a server to a VPC. A configured
+// local_ip is always kept; an address computed in the old VPC is not carried over.
+func serverVPCAttachPayload(d *schema.ResourceData, serverID string) map[string]interface{} {
+	payload := map[string]interface{}{
+		"server_id": serverID,
+	}
+	if localIP := d.Get("local_ip").(string); localIP != "" && isSetInConfig(d, "local_ip") {
+		payload["ip"] = localIP
+	}
+	return payload
+}
+
+// Helper to find the VPC and private address of a server API object
+func serverLocalNetwork(server map[string]interface{}) (vpcID, localIP string) {
+	networks, _ := server["networks"].([]interface{})
+	for _, n := range networks {
+		network, ok := n.(map[string]interface{})
+		if !ok || network["type"] != "local" {
+			continue
+		}
+		vpcID = idToString(network["id"])
+		ips, _ := network["ips"].([]interface{})
+		for _, i := range ips {
+			if ip, ok := i.(map[string]interface{}); ok && ip["type"] == "ipv4" {
+				localIP, _ = ip["ip"].(string)
+				break
+			}
+		}
+		return vpcID, localIP
+	}
+	return "", ""
+}
+
 func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	token := meta.(string)
 	id := d.Id()
@@ -203,6 +311,35 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	// Moving a server between VPCs detaches it from the old one first
+	if d.HasChanges("vpc_id", "local_ip") {
+		oldVPC, newVPC := d.GetChange("vpc_id")
+		if oldVPC.(string) != "" {
+			_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/vpcs/%s/servers/%s", oldVPC.(string), id), token, nil)
+			if err != nil && !isNotFoundError(err) {
+				return diag.FromErr(err)
+			}
+		}
+		if newVPC.(string) != "" {
+			_, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/vpcs/%s/servers", newVPC.(string)), token, serverVPCAttachPayload(d, id))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err := waitForServerReady(token, id, 20*time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if projectID := d.Get("project_id").(string); projectID != "" {
+			if err := moveToProject(token, projectID, "servers", id); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceServerRead(ctx, d, meta)
 }
 
@@ -210,11 +347,17 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	token := meta.(string)
 	id := d.Id()
 
-	_, err := makeRequest("DELETE", fmt.Sprintf("https://hostman.com/api/v1/servers/%s", id), token, nil)
-	if err != nil {
+	url := fmt.Sprintf("https://hostman.com/api/v1/servers/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
 		return diag.FromErr(err)
 	}
 
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for server %s deletion: %s", id, err)
+	}
+
 	d.SetId("")
 	return nil
 }
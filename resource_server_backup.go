@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServerBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerBackupCreate,
+		ReadContext:   resourceServerBackupRead,
+		UpdateContext: resourceServerBackupUpdate,
+		DeleteContext: resourceServerBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the server the disk belongs to",
+			},
+			"disk_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the disk to back up (e.g., hostman_server.boot_disk_id)",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the backup",
+			},
+			"restore_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that restores the disk from this backup whenever it changes",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the backup",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the backup in MB",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the backup",
+			},
+		},
+	}
+}
+
+func resourceServerBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+	diskID := d.Get("disk_id").(int)
+
+	payload := map[string]interface{}{}
+	if comment := d.Get("comment").(string); comment != "" {
+		payload["comment"] = comment
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/servers/%s/disks/%d/backups", serverID, diskID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	backup := resp["backup"].(map[string]interface{})
+	id := idToString(backup["id"])
+	d.SetId(id)
+
+	if err := waitForServerBackupDone(token, serverID, diskID, id, 60*time.Minute); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServerBackupRead(ctx, d, meta)
+}
+
+func resourceServerBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", serverBackupURL(d.Get("server_id").(string), d.Get("disk_id").(int), d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The backup was deleted or rotated out outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	backup := resp["backup"].(map[string]interface{})
+	if comment, ok := backup["comment"].(string); ok {
+		d.Set("comment", comment)
+	}
+	if size, ok := backup["size"].(float64); ok {
+		d.Set("size", int(size))
+	}
+	if createdAt, ok := backup["created_at"].(string); ok {
+		d.Set("created_at", createdAt)
+	}
+	d.Set("status", backup["status"])
+
+	return nil
+}
+
+func resourceServerBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+	diskID := d.Get("disk_id").(int)
+	id := d.Id()
+
+	if d.HasChange("comment") {
+		changes := map[string]interface{}{
+			"comment": d.Get("comment").(string),
+		}
+		_, err := makeRequest("PATCH", serverBackupURL(serverID, diskID, id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("restore_trigger") {
+		payload := map[string]interface{}{
+			"action": "restore",
+		}
+		_, err := makeRequest("POST", serverBackupURL(serverID, diskID, id)+"/action", token, payload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The backup stays done while the disk is restored, so the server status is polled instead
+		url := fmt.Sprintf("https://hostman.com/api/v1/servers/%s", serverID)
+		if err := waitForServerRestored(url, token, 60*time.Minute, 2*time.Minute, 10*time.Second); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServerBackupRead(ctx, d, meta)
+}
+
+func resourceServerBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := serverBackupURL(d.Get("server_id").(string), d.Get("disk_id").(int), id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 10*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for backup %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper to build the URL of a disk backup
+func serverBackupURL(serverID string, diskID int, id string) string {
+	return fmt.Sprintf("https://hostman.com/api/v1/servers/%s/disks/%d/backups/%s", serverID, diskID, id)
+}
+
+// Helper to poll a backup until it is created
+func waitForServerBackupDone(token, serverID string, diskID int, id string, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for backup %s to complete", id)
+		}
+
+		body, err := makeRequest("GET", serverBackupURL(serverID, diskID, id), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		backup, _ := resp["backup"].(map[string]interface{})
+		status, _ := backup["status"].(string)
+		switch status {
+		case "fail", "failed", "error":
+			return fmt.Errorf("backup %s failed with status: %s", id, status)
+		case "done":
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Helper to poll a server URL while one of its disks is restored from a backup.
+// Right after the restore is requested the server still reports its previous
+// status, so it has to leave the on/off states before its return to them counts
+// as done. A restore that is never seen in progress within settleWait is assumed
+// to have finished before the first poll.
+func waitForServerRestored(url, token string, maxWait, settleWait, interval time.Duration) error {
+	start := time.Now()
+	restoring := false
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for the disk restore to complete")
+		}
+
+		body, err := makeRequest("GET", url, token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		server, _ := resp["server"].(map[string]interface{})
+		status, _ := server["status"].(string)
+		switch {
+		case status != "on" && status != "off":
+			restoring = true
+		case restoring || time.Since(start) > settleWait:
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServerBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerBackupScheduleCreate,
+		ReadContext:   resourceServerBackupScheduleRead,
+		UpdateContext: resourceServerBackupScheduleUpdate,
+		DeleteContext: resourceServerBackupScheduleDelete,
+		CustomizeDiff: resourceServerBackupScheduleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the server the disk belongs to",
+			},
+			"disk_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the disk to back up (e.g., hostman_server.boot_disk_id)",
+			},
+			"interval": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"day", "week", "month"}, false),
+				Description:  "How often backups are created: day, week or month",
+			},
+			"day_of_week": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+				Description:  "Day of the week backups are created on, from 1 (Monday) to 7 (Sunday). Required when interval is week",
+			},
+			"copy_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 99),
+				Description:  "Number of backup copies to keep",
+			},
+			"start_at": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Date and time the first backup is created, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func resourceServerBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+	diskID := d.Get("disk_id").(int)
+
+	_, err := makeRequest("PATCH", serverBackupScheduleURL(serverID, diskID), token, serverBackupSchedulePayload(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", serverID, diskID))
+
+	return resourceServerBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceServerBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	body, err := makeRequest("GET", serverBackupScheduleURL(d.Get("server_id").(string), d.Get("disk_id").(int)), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The server or disk was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule := resp["auto_backups_settings"].(map[string]interface{})
+	if enabled, ok := schedule["is_enabled"].(bool); ok && !enabled {
+		// Automatic backups were disabled outside of Terraform
+		d.SetId("")
+		return nil
+	}
+
+	interval, _ := schedule["interval"].(string)
+	if interval != "" {
+		d.Set("interval", interval)
+	}
+	// A day left over from an earlier weekly schedule does not apply to other intervals
+	dayOfWeek, _ := schedule["day_of_week"].(float64)
+	if interval != "week" {
+		dayOfWeek = 0
+	}
+	d.Set("day_of_week", int(dayOfWeek))
+	if copyCount, ok := schedule["copy_count"].(float64); ok {
+		d.Set("copy_count", int(copyCount))
+	}
+	if startAt, ok := schedule["creation_start_at"].(string); ok {
+		d.Set("start_at", startAt)
+	}
+
+	return nil
+}
+
+func resourceServerBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	if d.HasChanges("interval", "day_of_week", "copy_count", "start_at") {
+		_, err := makeRequest("PATCH", serverBackupScheduleURL(d.Get("server_id").(string), d.Get("disk_id").(int)), token, serverBackupSchedulePayload(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServerBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceServerBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	// Schedules cannot be deleted, only disabled. Existing backups are kept.
+	payload := map[string]interface{}{
+		"is_enabled": false,
+	}
+	_, err := makeRequest("PATCH", serverBackupScheduleURL(d.Get("server_id").(string), d.Get("disk_id").(int)), token, payload)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceServerBackupScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("interval").(string) == "week" && d.Get("day_of_week").(int) == 0 {
+		return fmt.Errorf("day_of_week must be set when interval is week")
+	}
+
+	return nil
+}
+
+// Helper to build the automatic backup settings URL of a server disk
+func serverBackupScheduleURL(serverID string, diskID int) string {
+	return fmt.Sprintf("https://hostman.com/api/v1/servers/%s/disks/%d/auto-backups", serverID, diskID)
+}
+
+// Helper to build the automatic backup settings request from the resource configuration
+func serverBackupSchedulePayload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"is_enabled": true,
+		"interval":   d.Get("interval").(string),
+		"copy_count": d.Get("copy_count").(int),
+	}
+	// The day is cleared explicitly, otherwise the API keeps it when switching away from weekly backups
+	if dayOfWeek := d.Get("day_of_week").(int); dayOfWeek != 0 && payload["interval"] == "week" {
+		payload["day_of_week"] = dayOfWeek
+	} else {
+		payload["day_of_week"] = nil
+	}
+	if startAt := d.Get("start_at").(string); startAt != "" {
+		payload["creation_start_at"] = startAt
+	}
+	return payload
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServerDisk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerDiskCreate,
+		ReadContext:   resourceServerDiskRead,
+		UpdateContext: resourceServerDiskUpdate,
+		DeleteContext: resourceServerDiskDelete,
+		CustomizeDiff: resourceServerDiskCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the server the disk is attached to",
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Disk size in GB. Disks can only be grown",
+			},
+			"system_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Device name of the disk inside the server (e.g., vdb)",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Disk type (e.g., nvme, ssd, hdd)",
+			},
+			"is_mounted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the disk is mounted in the server",
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Used disk space in MB",
+			},
+		},
+	}
+}
+
+func resourceServerDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+
+	// The API expects sizes in MB
+	payload := map[string]interface{}{
+		"size": d.Get("size").(int) * 1024,
+	}
+
+	body, err := makeRequest("POST", fmt.Sprintf("https://hostman.com/api/v1/servers/%s/disks", serverID), token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	disk := resp["server_disk"].(map[string]interface{})
+	d.SetId(idToString(disk["id"]))
+
+	if err := waitForServerDiskSize(serverDiskURL(serverID, d.Id()), token, payload["size"].(int), 20*time.Minute, 10*time.Second); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceServerDiskRead(ctx, d, meta)
+}
+
+func resourceServerDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+
+	body, err := makeRequest("GET", serverDiskURL(serverID, d.Id()), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The disk was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	disk := resp["server_disk"].(map[string]interface{})
+	if size, ok := disk["size"].(float64); ok {
+		d.Set("size", int(size)/1024)
+	}
+	if used, ok := disk["used"].(float64); ok {
+		d.Set("used", int(used))
+	}
+	if systemName, ok := disk["system_name"].(string); ok {
+		d.Set("system_name", systemName)
+	}
+	if diskType, ok := disk["type"].(string); ok {
+		d.Set("type", diskType)
+	}
+	if isMounted, ok := disk["is_mounted"].(bool); ok {
+		d.Set("is_mounted", isMounted)
+	}
+
+	return nil
+}
+
+func resourceServerDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+
+	if d.HasChange("size") {
+		changes := map[string]interface{}{
+			"size": d.Get("size").(int) * 1024,
+		}
+		_, err := makeRequest("PATCH", serverDiskURL(serverID, d.Id()), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForServerDiskSize(serverDiskURL(serverID, d.Id()), token, changes["size"].(int), 20*time.Minute, 10*time.Second); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServerDiskRead(ctx, d, meta)
+}
+
+func resourceServerDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	serverID := d.Get("server_id").(string)
+	id := d.Id()
+
+	url := serverDiskURL(serverID, id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// The disk is only gone once the server has finished detaching it
+	if err := waitForDeletion(url, token, 20*time.Minute, 10*time.Second); err != nil {
+		return diag.Errorf("error waiting for disk %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceServerDiskCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only existing disks can shrink
+	if d.Id() == "" || !d.HasChange("size") {
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("size")
+	if newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("disk size cannot be decreased from %d GB to %d GB, create a new disk instead", oldSize.(int), newSize.(int))
+	}
+
+	return nil
+}
+
+// Helper to build the URL of a server disk
+func serverDiskURL(serverID, id string) string {
+	return fmt.Sprintf("https://hostman.com/api/v1/servers/%s/disks/%s", serverID, id)
+}
+
+// Helper to poll a disk URL until the disk reports the requested size in MB.
+// The server status still reads on/off right after a disk is added or resized,
+// so the disk itself is checked. Authentication errors fail immediately; other
+// errors are retried until maxWait.
+func waitForServerDiskSize(url, token string, size int, maxWait, interval time.Duration) error {
+	start := time.Now()
+	var lastErr error
+
+	for {
+		if time.Since(start) > maxWait {
+			if lastErr != nil {
+				return fmt.Errorf("timeout waiting for disk to reach %d MB, last error: %w", size, lastErr)
+			}
+			return fmt.Errorf("timeout waiting for disk to reach %d MB", size)
+		}
+
+		body, err := makeRequest("GET", url, token, nil)
+		switch {
+		case err == nil:
+			var resp map[string]interface{}
+			if err := json.Unmarshal(body, &resp); err != nil {
+				return err
+			}
+			disk, _ := resp["server_disk"].(map[string]interface{})
+			if current, ok := disk["size"].(float64); ok && int(current) == size {
+				return nil
+			}
+		case isAuthError(err):
+			return err
+		default:
+			lastErr = err
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Helper to poll a server until it has finished applying changes
+func waitForServerReady(token, serverID string, maxWait time.Duration) error {
+	interval := 10 * time.Second
+	start := time.Now()
+
+	for {
+		if time.Since(start) > maxWait {
+			return fmt.Errorf("timeout waiting for server %s to finish the operation", serverID)
+		}
+
+		body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/servers/%s", serverID), token, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+
+		server, _ := resp["server"].(map[string]interface{})
+		status, _ := server["status"].(string)
+		if status == "on" || status == "off" {
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -1,16 +1,25 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestResourceServer(t *testing.T) {
 	resource := resourceServer()
 
 	// Test that the resource has the correct schema
-	expectedFields := []string{"name", "bandwidth", "preset_id", "os_id", "image_id", "is_ddos_guard", "root_pass"}
+	expectedFields := []string{"name", "bandwidth", "preset_id", "os_id", "image_id", "is_ddos_guard", "root_pass", "boot_disk_id", "vpc_id", "local_ip"}
 	for _, field := range expectedFields {
 		if _, ok := resource.Schema[field]; !ok {
 			t.Errorf("expected field %q not found in schema", field)
@@ -26,7 +35,7 @@ func TestResourceServer(t *testing.T) {
 	}
 
 	// Test computed fields
-	computedFields := []string{"root_pass"}
+	computedFields := []string{"root_pass", "boot_disk_id", "local_ip"}
 	for _, field := range computedFields {
 		if !resource.Schema[field].Computed {
 			t.Errorf("expected field %q to be computed", field)
@@ -125,7 +134,7 @@ func TestResourceKubernetes(t *testing.T) {
 	resource := resourceKubernetes()
 
 	// Test that the resource has the correct schema
-	expectedFields := []string{"name", "k8s_version", "network_driver", "availability_zone", "cluster_id", "endpoint", "kubeconfig", "status"}
+	expectedFields := []string{"name", "k8s_version", "network_driver", "availability_zone", "cluster_id", "endpoint", "kubeconfig", "status", "host", "cluster_ca_certificate", "client_certificate", "client_key", "token", "client_certificate_expires_at", "rotate_credentials_trigger"}
 	for _, field := range expectedFields {
 		if _, ok := resource.Schema[field]; !ok {
 			t.Errorf("expected field %q not found in schema", field)
@@ -141,7 +150,7 @@ func TestResourceKubernetes(t *testing.T) {
 	}
 
 	// Test computed fields
-	computedFields := []string{"cluster_id", "endpoint", "kubeconfig", "status"}
+	computedFields := []string{"cluster_id", "endpoint", "kubeconfig", "status", "host", "cluster_ca_certificate", "client_certificate", "client_key", "token", "client_certificate_expires_at"}
 	for _, field := range computedFields {
 		if !resource.Schema[field].Computed {
 			t.Errorf("expected field %q to be computed", field)
@@ -149,7 +158,7 @@ func TestResourceKubernetes(t *testing.T) {
 	}
 
 	// Test sensitive fields
-	sensitiveFields := []string{"kubeconfig"}
+	sensitiveFields := []string{"kubeconfig", "client_key", "token"}
 	for _, field := range sensitiveFields {
 		if !resource.Schema[field].Sensitive {
 			t.Errorf("expected field %q to be sensitive", field)
@@ -184,3 +193,862 @@ func TestResourceKubernetesSchema(t *testing.T) {
 		t.Errorf("expected availability_zone to be 'ams-1', got %v", data.Get("availability_zone"))
 	}
 }
+
+func TestResourceK8sAddon(t *testing.T) {
+	resource := resourceK8sAddon()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"cluster_id", "type", "version", "config", "status"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Test required fields
+	requiredFields := []string{"cluster_id", "type"}
+	for _, field := range requiredFields {
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"version", "config", "status"}
+	for _, field := range computedFields {
+		if !resource.Schema[field].Computed {
+			t.Errorf("expected field %q to be computed", field)
+		}
+	}
+}
+
+func TestResourceImage(t *testing.T) {
+	resource := resourceImage()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"name", "description", "disk_id", "upload_url", "os", "location", "status", "size", "created_at"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	if !resource.Schema["name"].Required {
+		t.Error("expected field \"name\" to be required")
+	}
+
+	// Exactly one image source must be given, and changing it recreates the image
+	for _, field := range []string{"disk_id", "upload_url"} {
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+		if len(resource.Schema[field].ExactlyOneOf) != 2 {
+			t.Errorf("expected field %q to be one of disk_id and upload_url", field)
+		}
+	}
+}
+
+func TestResourceServerBackup(t *testing.T) {
+	resource := resourceServerBackup()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"server_id", "disk_id", "comment", "restore_trigger", "status", "size", "created_at"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Test required fields
+	requiredFields := []string{"server_id", "disk_id"}
+	for _, field := range requiredFields {
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+
+	// Restoring is done in place, never by recreating the backup
+	if resource.Schema["restore_trigger"].ForceNew {
+		t.Error("expected restore_trigger to be updated in place")
+	}
+}
+
+func TestWaitForServerRestored(t *testing.T) {
+	testCases := []struct {
+		name          string
+		statuses      []string
+		settleWait    time.Duration
+		expectErr     bool
+		expectedCalls int32
+	}{
+		{
+			name:          "waits for the server to come back after restoring",
+			statuses:      []string{"on", "on", "backup_restoring", "off", "on"},
+			settleWait:    time.Second,
+			expectedCalls: 4,
+		},
+		{
+			name:          "restore finished before the first poll",
+			statuses:      []string{"on"},
+			settleWait:    30 * time.Millisecond,
+			expectedCalls: 2,
+		},
+		{
+			name:       "restore never finishes",
+			statuses:   []string{"on", "backup_restoring"},
+			settleWait: time.Second,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&calls, 1)) - 1
+				if n >= len(tc.statuses) {
+					n = len(tc.statuses) - 1
+				}
+				fmt.Fprintf(w, `{"server": {"id": 1, "status": %q}}`, tc.statuses[n])
+			}))
+			defer server.Close()
+
+			err := waitForServerRestored(server.URL, "test-token", 200*time.Millisecond, tc.settleWait, 10*time.Millisecond)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := atomic.LoadInt32(&calls); got < tc.expectedCalls {
+				t.Errorf("expected at least %d polls, got %d", tc.expectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestServerBackupSchedulePayload(t *testing.T) {
+	resource := resourceServerBackupSchedule()
+
+	testCases := []struct {
+		name     string
+		state    map[string]string
+		config   map[string]interface{}
+		expected interface{}
+	}{
+		{
+			name:     "weekly backups send the day",
+			config:   map[string]interface{}{"interval": "week", "day_of_week": 3},
+			expected: 3,
+		},
+		{
+			name:     "switching from week to day clears the day",
+			state:    map[string]string{"interval": "week", "day_of_week": "3", "copy_count": "1"},
+			config:   map[string]interface{}{"interval": "day"},
+			expected: nil,
+		},
+		{
+			name:     "a day set with another interval is not sent",
+			config:   map[string]interface{}{"interval": "month", "day_of_week": 3},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if tc.state != nil {
+				state = &terraform.InstanceState{ID: "1/2", Attributes: tc.state}
+			}
+			cfg := map[string]interface{}{"server_id": "1", "disk_id": 2}
+			for k, v := range tc.config {
+				cfg[k] = v
+			}
+			diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), nil)
+			if err != nil {
+				t.Fatalf("unexpected diff error: %v", err)
+			}
+			d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatalf("unexpected data error: %v", err)
+			}
+
+			payload := serverBackupSchedulePayload(d)
+			dayOfWeek, ok := payload["day_of_week"]
+			if !ok {
+				t.Fatal("expected day_of_week to always be sent")
+			}
+			if dayOfWeek != tc.expected {
+				t.Errorf("expected day_of_week %v, got %v", tc.expected, dayOfWeek)
+			}
+		})
+	}
+}
+
+func TestResourceVPC(t *testing.T) {
+	resource := resourceVPC()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"name", "subnet_v4", "location", "description", "availability_zone", "created_at"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Test required fields
+	requiredFields := []string{"name", "subnet_v4", "location"}
+	for _, field := range requiredFields {
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+	}
+
+	// The subnet and location of a VPC cannot be changed
+	for _, field := range []string{"subnet_v4", "location"} {
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+}
+
+func TestResourceFirewallAttachment(t *testing.T) {
+	resource := resourceFirewallAttachment()
+
+	// Attachments cannot be changed in place, every argument forces a new resource
+	for _, field := range []string{"group_id", "resource_type", "resource_id"} {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Fatalf("expected field %q not found in schema", field)
+		}
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+
+	if resource.UpdateContext != nil {
+		t.Error("expected firewall attachment to have no update function")
+	}
+}
+
+func TestResourceLoadBalancer(t *testing.T) {
+	resource := resourceLoadBalancer()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"name", "preset_id", "algorithm", "is_sticky", "rule", "health_check", "ips", "server_ids", "backend_ips", "ip", "status"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Test required fields
+	requiredFields := []string{"name", "preset_id", "rule"}
+	for _, field := range requiredFields {
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"backend_ips", "ip", "status"}
+	for _, field := range computedFields {
+		if !resource.Schema[field].Computed {
+			t.Errorf("expected field %q to be computed", field)
+		}
+	}
+
+	if resource.Schema["health_check"].MaxItems != 1 {
+		t.Error("expected a single health_check block")
+	}
+}
+
+func TestDiffLoadBalancerRules(t *testing.T) {
+	current := []loadBalancerRule{
+		{ID: 1, BalancerProto: "http", BalancerPort: 80, ServerProto: "http", ServerPort: 8080},
+		{ID: 2, BalancerProto: "tcp", BalancerPort: 5432, ServerProto: "tcp", ServerPort: 5432},
+	}
+	desired := []loadBalancerRule{
+		{BalancerProto: "http", BalancerPort: 80, ServerProto: "http", ServerPort: 8080},
+		{BalancerProto: "https", BalancerPort: 443, ServerProto: "http", ServerPort: 8080},
+	}
+
+	remove, add := diffLoadBalancerRules(current, desired)
+	if len(remove) != 1 || remove[0].ID != 2 {
+		t.Errorf("expected rule 2 to be removed, got %v", remove)
+	}
+	if len(add) != 1 || add[0].BalancerPort != 443 {
+		t.Errorf("expected the https rule to be added, got %v", add)
+	}
+
+	remove, add = diffLoadBalancerRules(current, current)
+	if len(remove) != 0 || len(add) != 0 {
+		t.Errorf("expected no changes for identical rules, got remove=%v add=%v", remove, add)
+	}
+}
+
+func TestOrderLoadBalancerRules(t *testing.T) {
+	rules := []loadBalancerRule{
+		{ID: 3, BalancerProto: "tcp", BalancerPort: 22, ServerProto: "tcp", ServerPort: 22},
+		{ID: 1, BalancerProto: "https", BalancerPort: 443, ServerProto: "http", ServerPort: 8080},
+		{ID: 2, BalancerProto: "http", BalancerPort: 80, ServerProto: "http", ServerPort: 8080},
+	}
+	configured := []loadBalancerRule{
+		{BalancerProto: "http", BalancerPort: 80, ServerProto: "http", ServerPort: 8080},
+		{BalancerProto: "https", BalancerPort: 443, ServerProto: "http", ServerPort: 8080},
+	}
+
+	ordered := orderLoadBalancerRules(rules, configured)
+	expectedIDs := []int{2, 1, 3}
+	for i, id := range expectedIDs {
+		if ordered[i].ID != id {
+			t.Errorf("position %d: expected rule %d, got %d", i, id, ordered[i].ID)
+		}
+	}
+}
+
+func TestLoadBalancerMatches(t *testing.T) {
+	// The balancer as reported before the change has been applied
+	balancer := map[string]interface{}{
+		"name":      "web",
+		"algo":      "roundrobin",
+		"is_sticky": false,
+		"port":      float64(80),
+		"path":      nil,
+		"preset_id": float64(391),
+	}
+
+	testCases := []struct {
+		name     string
+		changes  map[string]interface{}
+		expected bool
+	}{
+		{name: "unchanged settings", changes: map[string]interface{}{"name": "web", "algo": "roundrobin", "port": 80, "path": "/"}, expected: true},
+		{name: "algorithm not applied yet", changes: map[string]interface{}{"algo": "leastconn"}, expected: false},
+		{name: "health check port not applied yet", changes: map[string]interface{}{"port": 8080}, expected: false},
+		{name: "preset not applied yet", changes: map[string]interface{}{"preset_id": 392}, expected: false},
+		{name: "setting not reported by the API", changes: map[string]interface{}{"is_keepalive": true}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if matches := loadBalancerMatches(balancer, tc.changes); matches != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, matches)
+			}
+		})
+	}
+}
+
+func TestLoadBalancerStateIPs(t *testing.T) {
+	backendIPs := []string{"203.0.113.10", "203.0.113.20", "203.0.113.30"}
+	serverIPs := []string{"203.0.113.20", "203.0.113.30"}
+
+	testCases := []struct {
+		name          string
+		configuredIPs []string
+		expected      []string
+	}{
+		{name: "server addresses are left out", expected: []string{"203.0.113.10"}},
+		// An address listed in ips that also belongs to a server must stay, otherwise every plan shows a diff
+		{name: "configured server address is kept", configuredIPs: []string{"203.0.113.10", "203.0.113.30"}, expected: []string{"203.0.113.10", "203.0.113.30"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ips := loadBalancerStateIPs(backendIPs, serverIPs, tc.configuredIPs)
+			if len(ips) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, ips)
+			}
+			for i, ip := range tc.expected {
+				if ips[i] != ip {
+					t.Errorf("expected %v, got %v", tc.expected, ips)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseCluster(t *testing.T) {
+	resource := resourceDatabaseCluster()
+
+	// Test that the resource has the correct schema
+	expectedFields := []string{"name", "type", "version", "preset_id", "configuration", "network_id", "location", "replication", "host", "port", "local_ip", "status"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// The engine, version and placement cannot be changed in place
+	for _, field := range []string{"type", "version", "network_id", "location"} {
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+
+	// Resizing is done in place
+	for _, field := range []string{"preset_id", "configuration", "replication"} {
+		if resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to be updated in place", field)
+		}
+	}
+}
+
+func TestDatabaseClusterUsesPreset(t *testing.T) {
+	// The API reports both a preset and a configurator
+	db := map[string]interface{}{
+		"preset_id":    float64(1),
+		"configurator": map[string]interface{}{"id": float64(2), "disk": float64(10), "cpu": float64(1), "ram": float64(1024)},
+	}
+	configuration := []interface{}{
+		map[string]interface{}{"configurator_id": 2, "disk": 10, "cpu": 1, "ram": 1024},
+	}
+
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		db       map[string]interface{}
+		expected bool
+	}{
+		{name: "preset in state", raw: map[string]interface{}{"preset_id": 1}, db: db, expected: true},
+		{name: "configuration in state", raw: map[string]interface{}{"configuration": configuration}, db: db, expected: false},
+		{name: "import with preset", raw: map[string]interface{}{}, db: db, expected: true},
+		{name: "import without preset", raw: map[string]interface{}{}, db: map[string]interface{}{"configurator": db["configurator"]}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceDatabaseCluster().Schema, tc.raw)
+			if usesPreset := databaseClusterUsesPreset(d, tc.db); usesPreset != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, usesPreset)
+			}
+		})
+	}
+}
+
+func TestDatabaseClusterMatches(t *testing.T) {
+	// The cluster as reported before a resize has been applied
+	db := map[string]interface{}{
+		"preset_id":    float64(1),
+		"configurator": map[string]interface{}{"id": float64(2), "disk": float64(10), "cpu": float64(1), "ram": float64(1024)},
+		"replication":  map[string]interface{}{"count": float64(1)},
+	}
+
+	testCases := []struct {
+		name     string
+		changes  map[string]interface{}
+		expected bool
+	}{
+		{name: "no sizing changes", changes: map[string]interface{}{"name": "renamed"}, expected: true},
+		{name: "preset not applied yet", changes: map[string]interface{}{"preset_id": 3}, expected: false},
+		{name: "preset applied", changes: map[string]interface{}{"preset_id": 1}, expected: true},
+		{name: "configuration not applied yet", changes: map[string]interface{}{"configurator": map[string]interface{}{"id": 2, "disk": 20, "cpu": 1, "ram": 1024}}, expected: false},
+		{name: "configuration applied", changes: map[string]interface{}{"configurator": map[string]interface{}{"id": 2, "disk": 10, "cpu": 1, "ram": 1024}}, expected: true},
+		{name: "replication not turned off yet", changes: map[string]interface{}{"replication": map[string]interface{}{"count": 0}}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if matches := databaseClusterMatches(db, tc.changes); matches != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, matches)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseUser(t *testing.T) {
+	resource := resourceDatabaseUser()
+
+	// Test required fields
+	requiredFields := []string{"cluster_id", "login", "password"}
+	for _, field := range requiredFields {
+		if !resource.Schema[field].Required {
+			t.Errorf("expected field %q to be required", field)
+		}
+	}
+
+	if !resource.Schema["password"].Sensitive {
+		t.Error("expected password to be sensitive")
+	}
+}
+
+func TestDatabaseUserPrivileges(t *testing.T) {
+	privileges := []interface{}{
+		map[string]interface{}{
+			"instance_id": "101",
+			"privileges":  schema.NewSet(schema.HashString, []interface{}{"SELECT", "INSERT"}),
+		},
+	}
+
+	instances := expandDatabaseUserPrivileges(privileges)
+	if len(instances) != 1 || instances[0]["instance_id"] != "101" {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+	granted := instances[0]["privileges"].([]string)
+	if len(granted) != 2 || granted[0] != "INSERT" || granted[1] != "SELECT" {
+		t.Errorf("expected sorted privileges, got %v", granted)
+	}
+
+	flattened := flattenDatabaseUserPrivileges([]interface{}{
+		map[string]interface{}{"instance_id": float64(101), "privileges": []interface{}{"SELECT"}},
+	})
+	grant := flattened[0].(map[string]interface{})
+	if grant["instance_id"] != "101" || len(grant["privileges"].([]string)) != 1 {
+		t.Errorf("unexpected flattened privileges: %v", grant)
+	}
+
+	// The API may list databases and privileges in a different order than the configuration
+	configured := schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{
+		"privileges": []interface{}{
+			map[string]interface{}{"instance_id": "101", "privileges": []interface{}{"SELECT", "INSERT"}},
+			map[string]interface{}{"instance_id": "102", "privileges": []interface{}{"SELECT"}},
+		},
+	})
+	refreshed := schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{})
+	err := refreshed.Set("privileges", flattenDatabaseUserPrivileges([]interface{}{
+		map[string]interface{}{"instance_id": float64(102), "privileges": []interface{}{"SELECT"}},
+		map[string]interface{}{"instance_id": float64(101), "privileges": []interface{}{"INSERT", "SELECT"}},
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !configured.Get("privileges").(*schema.Set).Equal(refreshed.Get("privileges")) {
+		t.Error("expected privileges in a different order to be equal")
+	}
+}
+
+func TestResourceS3BucketSubResources(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		fields   []string
+	}{
+		{name: "cors", resource: resourceS3BucketCORS(), fields: []string{"bucket_id", "cors_rule"}},
+		{name: "lifecycle", resource: resourceS3BucketLifecycle(), fields: []string{"bucket_id", "rule"}},
+		{name: "subdomain", resource: resourceS3BucketSubdomain(), fields: []string{"bucket_id", "subdomain", "issue_certificate", "status"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, field := range tc.fields {
+				if _, ok := tc.resource.Schema[field]; !ok {
+					t.Errorf("expected field %q not found in schema", field)
+				}
+			}
+
+			// Every sub-resource belongs to exactly one bucket
+			if !tc.resource.Schema["bucket_id"].Required || !tc.resource.Schema["bucket_id"].ForceNew {
+				t.Error("expected bucket_id to be required and force a new resource")
+			}
+		})
+	}
+}
+
+func TestResourceDNSRecordImport(t *testing.T) {
+	resource := resourceDNSRecord()
+
+	d := resource.TestResourceData()
+	d.SetId("example.com/12345")
+	states, err := resource.Importer.StateContext(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(states) != 1 {
+		t.Fatalf("expected 1 state, got %d", len(states))
+	}
+	if states[0].Id() != "12345" {
+		t.Errorf("expected ID 12345, got %q", states[0].Id())
+	}
+	if domain := states[0].Get("domain").(string); domain != "example.com" {
+		t.Errorf("expected domain example.com, got %q", domain)
+	}
+
+	for _, id := range []string{"12345", "example.com/", "/12345", "example.com/www/12345"} {
+		d := resource.TestResourceData()
+		d.SetId(id)
+		if _, err := resource.Importer.StateContext(context.Background(), d, nil); err == nil {
+			t.Errorf("expected import ID %q to be rejected", id)
+		}
+	}
+
+	// Domains are imported by name
+	if resourceDomain().Importer == nil {
+		t.Error("expected hostman_domain to support import")
+	}
+}
+
+func TestResourceProject(t *testing.T) {
+	resource := resourceProject()
+
+	for _, field := range []string{"name", "description", "is_default"} {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Moving a resource between projects must not recreate it
+	for name, r := range map[string]*schema.Resource{
+		"hostman_server":     resourceServer(),
+		"hostman_ip":         resourceIP(),
+		"hostman_kubernetes": resourceKubernetes(),
+	} {
+		projectID, ok := r.Schema["project_id"]
+		if !ok {
+			t.Errorf("%s: project_id not found in schema", name)
+			continue
+		}
+		if !projectID.Optional || !projectID.Computed || projectID.ForceNew {
+			t.Errorf("%s: expected project_id to be optional, computed and updatable in place", name)
+		}
+	}
+}
+
+func TestResourceContainerRegistry(t *testing.T) {
+	resource := resourceContainerRegistry()
+
+	for _, field := range []string{"name", "preset_id", "location", "description", "url", "username", "password", "docker_config_json", "status"} {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	for _, field := range []string{"password", "docker_config_json"} {
+		if !resource.Schema[field].Sensitive {
+			t.Errorf("expected %s to be sensitive", field)
+		}
+	}
+}
+
+func TestContainerRegistryReady(t *testing.T) {
+	testCases := []struct {
+		name      string
+		registry  map[string]interface{}
+		presetID  int
+		expected  bool
+		expectErr bool
+	}{
+		{name: "started", registry: map[string]interface{}{"status": "started", "preset_id": float64(1)}, expected: true},
+		{name: "still starting", registry: map[string]interface{}{"status": "starting", "preset_id": float64(1)}, expected: false},
+		{name: "old preset still reported", registry: map[string]interface{}{"status": "started", "preset_id": float64(1)}, presetID: 2, expected: false},
+		{name: "new preset reported", registry: map[string]interface{}{"status": "started", "preset_id": float64(2)}, presetID: 2, expected: true},
+		{name: "failed", registry: map[string]interface{}{"status": "failed"}, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, err := containerRegistryReady(tc.registry, tc.presetID)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ready != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, ready)
+			}
+		})
+	}
+}
+
+func TestResourceK8sRegistry(t *testing.T) {
+	resource := resourceK8sRegistry()
+
+	for _, field := range []string{"cluster_id", "registry_id", "namespace", "secret_name", "status"} {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// A connection is replaced rather than moved to another cluster, registry or namespace
+	for _, field := range []string{"cluster_id", "registry_id", "namespace"} {
+		if !resource.Schema[field].ForceNew {
+			t.Errorf("expected field %q to force a new resource", field)
+		}
+	}
+	if resource.UpdateContext != nil {
+		t.Error("expected no update function, all arguments force a new resource")
+	}
+}
+
+func TestRegistryDockerConfigJSON(t *testing.T) {
+	config, err := registryDockerConfigJSON("ci.registry.hostman.com", "ci", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+		t.Fatalf("failed to parse docker config: %v", err)
+	}
+
+	auth, ok := parsed.Auths["ci.registry.hostman.com"]
+	if !ok {
+		t.Fatalf("expected credentials for the registry host, got %s", config)
+	}
+	if auth.Username != "ci" || auth.Password != "secret" {
+		t.Errorf("unexpected credentials: %+v", auth)
+	}
+	// base64("ci:secret")
+	if auth.Auth != "Y2k6c2VjcmV0" {
+		t.Errorf("expected auth Y2k6c2VjcmV0, got %s", auth.Auth)
+	}
+
+	// Nothing to log in to until the registry host is known
+	if config, _ := registryDockerConfigJSON("", "ci", "secret"); config != "" {
+		t.Errorf("expected empty config without a host, got %s", config)
+	}
+}
+
+func TestResourceApp(t *testing.T) {
+	resource := resourceApp()
+
+	expectedFields := []string{"name", "type", "framework", "repository", "branch", "build_command", "run_command",
+		"env_vars", "preset_id", "is_auto_deploy", "redeploy_trigger", "domains", "status"}
+	for _, field := range expectedFields {
+		if _, ok := resource.Schema[field]; !ok {
+			t.Errorf("expected field %q not found in schema", field)
+		}
+	}
+
+	// Redeploying must not recreate the app
+	if resource.Schema["redeploy_trigger"].ForceNew {
+		t.Error("expected redeploy_trigger to be updatable in place")
+	}
+	if !resource.Schema["env_vars"].Sensitive {
+		t.Error("expected env_vars to be sensitive")
+	}
+}
+
+func TestFlattenAppDomains(t *testing.T) {
+	var domains interface{}
+	if err := json.Unmarshal([]byte(`[{"fqdn": "app-1.hostman.app"}, {"fqdn": ""}, {"fqdn": "shop.example.com"}]`), &domains); err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	result := flattenAppDomains(domains)
+	if len(result) != 2 || result[0] != "app-1.hostman.app" || result[1] != "shop.example.com" {
+		t.Errorf("unexpected domains: %v", result)
+	}
+
+	if result := flattenAppDomains(nil); result == nil || len(result) != 0 {
+		t.Errorf("expected an empty list, got %v", result)
+	}
+}
+
+func TestWaitForServerDiskSize(t *testing.T) {
+	testCases := []struct {
+		name      string
+		statuses  []int
+		sizes     []int
+		expectErr bool
+	}{
+		{
+			name:     "old size is reported until the resize finishes",
+			statuses: []int{http.StatusOK, http.StatusOK, http.StatusOK},
+			sizes:    []int{10240, 10240, 20480},
+		},
+		{
+			name:     "transient errors are retried",
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			sizes:    []int{0, 20480},
+		},
+		{
+			name:      "auth error fails immediately",
+			statuses:  []int{http.StatusForbidden, http.StatusOK},
+			sizes:     []int{0, 20480},
+			expectErr: true,
+		},
+		{
+			name:      "size never changes",
+			statuses:  []int{http.StatusOK},
+			sizes:     []int{10240},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&calls, 1)) - 1
+				if n >= len(tc.statuses) {
+					n = len(tc.statuses) - 1
+				}
+				w.WriteHeader(tc.statuses[n])
+				fmt.Fprintf(w, `{"server_disk": {"id": 1, "size": %d}}`, tc.sizes[n])
+			}))
+			defer server.Close()
+
+			err := waitForServerDiskSize(server.URL, "test-token", 20480, 200*time.Millisecond, 10*time.Millisecond)
+			if tc.expectErr && err == nil {
+				t.Fatal("expected error, but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestServerVPCAttachPayload(t *testing.T) {
+	testCases := []struct {
+		name       string
+		localIP    cty.Value
+		expectedIP string
+	}{
+		// Moving to another VPC keeps a fixed address even though local_ip did not change
+		{name: "configured local_ip", localIP: cty.StringVal("192.168.0.10"), expectedIP: "192.168.0.10"},
+		// An address assigned in the old VPC is not carried over
+		{name: "computed local_ip", localIP: cty.NullVal(cty.String), expectedIP: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := resourceServer().Data(&terraform.InstanceState{
+				ID: "42",
+				Attributes: map[string]string{
+					"vpc_id":   "vpc-2",
+					"local_ip": "192.168.0.10",
+				},
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"vpc_id":   cty.StringVal("vpc-2"),
+					"local_ip": tc.localIP,
+				}),
+			})
+
+			payload := serverVPCAttachPayload(d, "42")
+			if payload["server_id"] != "42" {
+				t.Errorf("expected server_id 42, got %v", payload["server_id"])
+			}
+			ip, _ := payload["ip"].(string)
+			if ip != tc.expectedIP {
+				t.Errorf("expected ip %q, got %q", tc.expectedIP, ip)
+			}
+		})
+	}
+
+	// ResourceData built outside of a Terraform run has no raw config
+	if _, ok := serverVPCAttachPayload(resourceServer().TestResourceData(), "42")["ip"]; ok {
+		t.Error("expected no ip without a configuration")
+	}
+}
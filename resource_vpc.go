@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceVPC() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCCreate,
+		ReadContext:   resourceVPCRead,
+		UpdateContext: resourceVPCUpdate,
+		DeleteContext: resourceVPCDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the VPC",
+			},
+			"subnet_v4": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Private IPv4 subnet of the VPC in CIDR notation (e.g., 192.168.0.0/24)",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Location of the VPC (e.g., nl-1)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the VPC",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Availability zone of the VPC",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the VPC",
+			},
+		},
+	}
+}
+
+func resourceVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+
+	payload := map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"subnet_v4": d.Get("subnet_v4").(string),
+		"location":  d.Get("location").(string),
+	}
+	if description := d.Get("description").(string); description != "" {
+		payload["description"] = description
+	}
+
+	body, err := makeRequest("POST", "https://hostman.com/api/v1/vpcs", token, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpc := resp["vpc"].(map[string]interface{})
+	d.SetId(idToString(vpc["id"]))
+
+	return resourceVPCRead(ctx, d, meta)
+}
+
+func resourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	body, err := makeRequest("GET", fmt.Sprintf("https://hostman.com/api/v1/vpcs/%s", id), token, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			// The VPC was deleted outside of Terraform
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpc := resp["vpc"].(map[string]interface{})
+	d.Set("name", vpc["name"])
+	if subnet, ok := vpc["subnet_v4"].(string); ok {
+		d.Set("subnet_v4", subnet)
+	}
+	if location, ok := vpc["location"].(string); ok {
+		d.Set("location", location)
+	}
+	if description, ok := vpc["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if availabilityZone, ok := vpc["availability_zone"].(string); ok {
+		d.Set("availability_zone", availabilityZone)
+	}
+	if createdAt, ok := vpc["created_at"].(string); ok {
+		d.Set("created_at", createdAt)
+	}
+
+	return nil
+}
+
+func resourceVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	changes := make(map[string]interface{})
+	if d.HasChange("name") {
+		changes["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		changes["description"] = d.Get("description").(string)
+	}
+
+	if len(changes) > 0 {
+		_, err := makeRequest("PATCH", fmt.Sprintf("https://hostman.com/api/v1/vpcs/%s", id), token, changes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVPCRead(ctx, d, meta)
+}
+
+func resourceVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	token := meta.(string)
+	id := d.Id()
+
+	url := fmt.Sprintf("https://hostman.com/api/v1/vpcs/%s", id)
+	_, err := makeRequest("DELETE", url, token, nil)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	// Wait for deletion to complete
+	if err := waitForDeletion(url, token, 5*time.Minute, 5*time.Second); err != nil {
+		return diag.Errorf("error waiting for VPC %s deletion: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
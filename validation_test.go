@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestResourceServerValidation(t *testing.T) {
@@ -263,3 +265,284 @@ func TestResourceSchemaTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceKubernetesSubnetValidation(t *testing.T) {
+	resource := resourceKubernetes()
+
+	for _, field := range []string{"pod_subnet", "service_subnet"} {
+		validate := resource.Schema[field].ValidateFunc
+		if validate == nil {
+			t.Fatalf("expected %q to have a ValidateFunc", field)
+		}
+
+		if _, errs := validate("10.244.0.0/16", field); len(errs) > 0 {
+			t.Errorf("%s: expected valid CIDR to pass, got %v", field, errs)
+		}
+
+		if _, errs := validate("10.244.0.0", field); len(errs) == 0 {
+			t.Errorf("%s: expected address without prefix length to fail", field)
+		}
+	}
+
+	if resource.CustomizeDiff == nil {
+		t.Error("expected CustomizeDiff to check pod_subnet and service_subnet for overlap")
+	}
+}
+
+func TestResourceServerBackupScheduleValidation(t *testing.T) {
+	resource := resourceServerBackupSchedule()
+
+	testCases := []struct {
+		field     string
+		value     interface{}
+		expectErr bool
+	}{
+		{field: "interval", value: "day", expectErr: false},
+		{field: "interval", value: "week", expectErr: false},
+		{field: "interval", value: "hour", expectErr: true},
+		{field: "day_of_week", value: 1, expectErr: false},
+		{field: "day_of_week", value: 7, expectErr: false},
+		{field: "day_of_week", value: 8, expectErr: true},
+		{field: "copy_count", value: 0, expectErr: true},
+		{field: "copy_count", value: 99, expectErr: false},
+	}
+
+	for _, tc := range testCases {
+		_, errs := resource.Schema[tc.field].ValidateFunc(tc.value, tc.field)
+		if tc.expectErr && len(errs) == 0 {
+			t.Errorf("%s = %v: expected validation error", tc.field, tc.value)
+		}
+		if !tc.expectErr && len(errs) > 0 {
+			t.Errorf("%s = %v: unexpected validation errors: %v", tc.field, tc.value, errs)
+		}
+	}
+
+	if resource.CustomizeDiff == nil {
+		t.Error("expected CustomizeDiff to require day_of_week for weekly backups")
+	}
+}
+
+func TestResourceServerDiskGrowOnly(t *testing.T) {
+	resource := resourceServerDisk()
+	state := &terraform.InstanceState{
+		ID: "4567",
+		Attributes: map[string]string{
+			"id":        "4567",
+			"server_id": "1234567",
+			"size":      "20",
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		size      int
+		expectErr bool
+	}{
+		{name: "grow", size: 40, expectErr: false},
+		{name: "unchanged", size: 20, expectErr: false},
+		{name: "shrink", size: 10, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"server_id": "1234567",
+				"size":      tc.size,
+			})
+
+			_, err := resource.Diff(context.Background(), state, config, nil)
+			if tc.expectErr && err == nil {
+				t.Error("expected shrinking the disk to fail")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceServerLocalIPValidation(t *testing.T) {
+	validate := resourceServer().Schema["local_ip"].ValidateFunc
+
+	if _, errs := validate("192.168.0.4", "local_ip"); len(errs) > 0 {
+		t.Errorf("expected valid IPv4 address to pass, got %v", errs)
+	}
+
+	for _, value := range []string{"192.168.0.0/24", "fd00::4", "server-1"} {
+		if _, errs := validate(value, "local_ip"); len(errs) == 0 {
+			t.Errorf("expected %q to fail validation", value)
+		}
+	}
+}
+
+func TestValidateFirewallPort(t *testing.T) {
+	testCases := []struct {
+		value     string
+		expectErr bool
+	}{
+		{value: "22", expectErr: false},
+		{value: "8000-9000", expectErr: false},
+		{value: "1-65535", expectErr: false},
+		{value: "0", expectErr: true},
+		{value: "65536", expectErr: true},
+		{value: "9000-8000", expectErr: true},
+		{value: "80,443", expectErr: true},
+		{value: "ssh", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		_, errs := validateFirewallPort(tc.value, "port")
+		if tc.expectErr && len(errs) == 0 {
+			t.Errorf("%q: expected validation error", tc.value)
+		}
+		if !tc.expectErr && len(errs) > 0 {
+			t.Errorf("%q: unexpected validation errors: %v", tc.value, errs)
+		}
+	}
+}
+
+func TestResourceFirewallRuleICMPPort(t *testing.T) {
+	resource := resourceFirewallRule()
+
+	testCases := []struct {
+		name      string
+		config    map[string]interface{}
+		expectErr bool
+	}{
+		{
+			name:      "tcp with port",
+			config:    map[string]interface{}{"group_id": "1", "direction": "ingress", "protocol": "tcp", "port": "22"},
+			expectErr: false,
+		},
+		{
+			name:      "icmp without port",
+			config:    map[string]interface{}{"group_id": "1", "direction": "ingress", "protocol": "icmp"},
+			expectErr: false,
+		},
+		{
+			name:      "icmp with port",
+			config:    map[string]interface{}{"group_id": "1", "direction": "ingress", "protocol": "icmp", "port": "22"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.expectErr && err == nil {
+				t.Error("expected icmp rule with a port to fail")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceS3BucketLifecycleActions(t *testing.T) {
+	resource := resourceS3BucketLifecycle()
+
+	testCases := []struct {
+		name      string
+		rule      map[string]interface{}
+		expectErr bool
+	}{
+		{name: "expiration", rule: map[string]interface{}{"id": "logs", "expiration_days": 30}},
+		{name: "multipart uploads", rule: map[string]interface{}{"id": "uploads", "abort_incomplete_multipart_upload_days": 7}},
+		{name: "no action", rule: map[string]interface{}{"id": "noop", "prefix": "tmp/"}, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"bucket_id": "1",
+				"rule":      []interface{}{tc.rule},
+			}
+			_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tc.expectErr && err == nil {
+				t.Error("expected a rule without actions to fail at plan time")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateDNSRecord(t *testing.T) {
+	testCases := []struct {
+		name       string
+		recordType string
+		value      string
+		priority   int
+		weight     int
+		port       int
+		expectErr  bool
+	}{
+		{name: "A record", recordType: "A", value: "203.0.113.10"},
+		{name: "A record with unknown value", recordType: "A", value: ""},
+		{name: "A record with IPv6 address", recordType: "A", value: "2001:db8::1", expectErr: true},
+		{name: "A record with host name", recordType: "A", value: "example.com", expectErr: true},
+		{name: "AAAA record", recordType: "AAAA", value: "2001:db8::1"},
+		{name: "AAAA record with IPv4 address", recordType: "AAAA", value: "203.0.113.10", expectErr: true},
+		{name: "MX record", recordType: "MX", value: "mail.example.com", priority: 10},
+		{name: "CNAME record with priority", recordType: "CNAME", value: "example.com", priority: 10, expectErr: true},
+		{name: "SRV record", recordType: "SRV", value: "sip.example.com", priority: 10, weight: 5, port: 5060},
+		{name: "SRV record without port", recordType: "SRV", value: "sip.example.com", priority: 10, expectErr: true},
+		{name: "MX record with port", recordType: "MX", value: "mail.example.com", port: 25, expectErr: true},
+		{name: "CAA record", recordType: "CAA", value: `0 issue "letsencrypt.org"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDNSRecord(tc.recordType, tc.value, tc.priority, tc.weight, tc.port)
+			if tc.expectErr && err == nil {
+				t.Error("expected validation error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceAppRunCommand(t *testing.T) {
+	resource := resourceApp()
+	base := map[string]interface{}{
+		"name":       "api",
+		"framework":  "express",
+		"repository": "https://github.com/example/api",
+		"preset_id":  1,
+	}
+
+	testCases := []struct {
+		name      string
+		config    map[string]interface{}
+		expectErr bool
+	}{
+		{name: "backend with run command", config: map[string]interface{}{"type": "backend", "run_command": "npm start"}},
+		{name: "backend without run command", config: map[string]interface{}{"type": "backend"}, expectErr: true},
+		{name: "frontend", config: map[string]interface{}{"type": "frontend", "build_command": "npm run build"}},
+		{name: "frontend with run command", config: map[string]interface{}{"type": "frontend", "run_command": "npm start"}, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			for k, v := range base {
+				config[k] = v
+			}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tc.expectErr && err == nil {
+				t.Error("expected validation error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}